@@ -0,0 +1,146 @@
+package apperror
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RetryAfterHeader is the response header telling a throttled client, in whole seconds, when to
+// retry.
+const RetryAfterHeader = "retry-after"
+
+// ErrNotFound is returned when the requested data does not exist.
+var ErrNotFound = errors.New("not found")
+
+// ErrPermissionDenied is returned when the caller may not access the requested data, the
+// wrapping message is sent to the client.
+var ErrPermissionDenied = errors.New("permission denied")
+
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError describes one or more invalid request fields.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		descriptions = append(descriptions, fmt.Sprintf("invalid [%s]: %s", violation.Field, violation.Description))
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+func NewValidationError(field string, description string) *ValidationError {
+	return &ValidationError{Violations: []FieldViolation{{Field: field, Description: description}}}
+}
+
+// DatabaseError wraps a failure of the storage layer; its message is never sent to clients.
+type DatabaseError struct {
+	Operation string
+	Err       error
+}
+
+func (e *DatabaseError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Operation, e.Err)
+}
+
+func (e *DatabaseError) Unwrap() error {
+	return e.Err
+}
+
+func NewDatabaseError(operation string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s: %w", operation, ErrNotFound)
+	}
+	return &DatabaseError{Operation: operation, Err: err}
+}
+
+// ResourceExhaustedError is returned when the caller has to back off before retrying.
+type ResourceExhaustedError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *ResourceExhaustedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", e.Reason, e.RetryAfter)
+}
+
+// ToStatus converts an error returned by the service layer into a gRPC status error, errors
+// which are not sent to the client as is are logged with ctx.
+func ToStatus(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var validationError *ValidationError
+	var resourceExhaustedError *ResourceExhaustedError
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, context.Canceled.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	case errors.As(err, &validationError):
+		return validationStatus(validationError)
+	case errors.Is(err, ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &resourceExhaustedError):
+		return resourceExhaustedStatus(ctx, resourceExhaustedError)
+	default:
+		slog.ErrorContext(ctx, "internal error", "error", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+func validationStatus(validationError *ValidationError) error {
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range validationError.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+	st, err := status.New(codes.InvalidArgument, validationError.Error()).WithDetails(badRequest)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, validationError.Error())
+	}
+	return st.Err()
+}
+
+// resourceExhaustedStatus sends the retry delay both as RetryInfo, for gRPC clients, and as the
+// retry-after header, for proxies and HTTP clients.
+func resourceExhaustedStatus(ctx context.Context, resourceExhaustedError *ResourceExhaustedError) error {
+	seconds := int(math.Ceil(resourceExhaustedError.RetryAfter.Seconds()))
+	// SetHeader fails outside of a server handler or once headers were sent, neither of which
+	// leaves anything to do.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, strconv.Itoa(max(seconds, 1))))
+	st, err := status.New(codes.ResourceExhausted, resourceExhaustedError.Error()).WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(resourceExhaustedError.RetryAfter),
+	})
+	if err != nil {
+		return status.Error(codes.ResourceExhausted, resourceExhaustedError.Error())
+	}
+	return st.Err()
+}
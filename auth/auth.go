@@ -0,0 +1,276 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/MicahParks/keyfunc/v3"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// AuthorizationHeader carries "Bearer <jwt>".
+	AuthorizationHeader = "authorization"
+	// APIKeyHeader carries a static API key.
+	APIKeyHeader = "x-api-key"
+
+	MethodJWT    = "jwt"
+	MethodAPIKey = "api_key"
+
+	// WorkspaceClaim is the JWT claim holding the caller's workspace ID.
+	WorkspaceClaim = "workspace_id"
+	// RoleClaim and UserClaim hold the caller's role and users.id, used for authorization.
+	RoleClaim = "role"
+	UserClaim = "user_id"
+)
+
+var (
+	ErrMissingCredentials = errors.New("missing credentials")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Identity is the authenticated caller.
+type Identity struct {
+	Subject string
+	// Method is MethodJWT or MethodAPIKey.
+	Method string
+	// WorkspaceID is the only workspace whose data the caller can read.
+	WorkspaceID int64
+	// Role and UserID are optional, they are only needed when an authorization policy is set.
+	Role   string
+	UserID int64
+	// Claims holds the JWT claims, it is empty for API keys.
+	Claims map[string]any
+}
+
+type identityKey struct{}
+
+// WithIdentity returns a copy of ctx carrying identity.
+func WithIdentity(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the identity stored in ctx by the auth interceptors.
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// Options configures an Authenticator. At least one of HMACSecretFile, JWKSFile or APIKeysFile
+// must be set.
+type Options struct {
+	// HMACSecretFile holds the secret for HS256, HS384 and HS512 tokens.
+	HMACSecretFile string
+	// JWKSFile is a JSON Web Key Set with the public keys for asymmetric tokens.
+	JWKSFile string
+	// Issuer and Audience are checked against the iss and aud claims when set.
+	Issuer   string
+	Audience string
+	// APIKeysFile is a YAML file listing the SHA-256 of every accepted API key.
+	APIKeysFile string
+	// ExemptMethods are full method names, or service prefixes ending in "/", that are served
+	// without credentials.
+	ExemptMethods []string
+}
+
+// APIKey is an entry of the API keys file. Only the hex SHA-256 of the key is stored.
+type APIKey struct {
+	Subject     string `yaml:"subject"`
+	WorkspaceID int64  `yaml:"workspace_id"`
+	Role        string `yaml:"role"`
+	UserID      int64  `yaml:"user_id"`
+	SHA256      string `yaml:"sha256"`
+}
+
+type apiKeysFile struct {
+	APIKeys []APIKey `yaml:"api_keys"`
+}
+
+// Authenticator validates the credentials sent in the request metadata.
+type Authenticator struct {
+	hmacSecret    []byte
+	jwks          keyfunc.Keyfunc
+	parser        *jwt.Parser
+	apiKeys       map[string]APIKey
+	exemptMethods []string
+}
+
+// NewAuthenticator loads the keys named in options.
+func NewAuthenticator(options Options) (*Authenticator, error) {
+	authenticator := &Authenticator{exemptMethods: options.ExemptMethods}
+	var methods []string
+	if options.HMACSecretFile != "" {
+		secret, err := os.ReadFile(options.HMACSecretFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load HMAC secret: %w", err)
+		}
+		authenticator.hmacSecret = []byte(strings.TrimSpace(string(secret)))
+		if len(authenticator.hmacSecret) < 32 {
+			return nil, errors.New("HMAC secret must be at least 32 bytes")
+		}
+		methods = append(methods, "HS256", "HS384", "HS512")
+	}
+	if options.JWKSFile != "" {
+		raw, err := os.ReadFile(options.JWKSFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load JWKS: %w", err)
+		}
+		authenticator.jwks, err = keyfunc.NewJWKSetJSON(raw)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JWKS %s: %w", options.JWKSFile, err)
+		}
+		methods = append(methods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA")
+	}
+	if options.APIKeysFile != "" {
+		keys, err := loadAPIKeys(options.APIKeysFile)
+		if err != nil {
+			return nil, err
+		}
+		authenticator.apiKeys = keys
+	}
+	if len(methods) == 0 && authenticator.apiKeys == nil {
+		return nil, errors.New("authentication needs an HMAC secret, a JWKS or API keys")
+	}
+
+	parserOptions := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithExpirationRequired()}
+	if options.Issuer != "" {
+		parserOptions = append(parserOptions, jwt.WithIssuer(options.Issuer))
+	}
+	if options.Audience != "" {
+		parserOptions = append(parserOptions, jwt.WithAudience(options.Audience))
+	}
+	authenticator.parser = jwt.NewParser(parserOptions...)
+	return authenticator, nil
+}
+
+func loadAPIKeys(path string) (map[string]APIKey, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load API keys: %w", err)
+	}
+	var file apiKeysFile
+	if err := yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse API keys file %s: %w", path, err)
+	}
+	keys := map[string]APIKey{}
+	for i, key := range file.APIKeys {
+		hash, err := hex.DecodeString(key.SHA256)
+		if err != nil || len(hash) != sha256.Size {
+			return nil, fmt.Errorf("API key %d in %s: sha256 must be 64 hex characters", i, path)
+		}
+		if key.Subject == "" {
+			return nil, fmt.Errorf("API key %d in %s: subject must be set", i, path)
+		}
+		if key.WorkspaceID <= 0 {
+			return nil, fmt.Errorf("API key %d in %s: workspace_id must be set", i, path)
+		}
+		keys[hex.EncodeToString(hash)] = key
+	}
+	return keys, nil
+}
+
+// Exempt reports whether method is served without credentials.
+func (authenticator *Authenticator) Exempt(method string) bool {
+	for _, exempt := range authenticator.exemptMethods {
+		if method == exempt || (strings.HasSuffix(exempt, "/") && strings.HasPrefix(method, exempt)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticate returns the identity of the caller described by the incoming metadata of ctx.
+func (authenticator *Authenticator) Authenticate(ctx context.Context) (Identity, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(AuthorizationHeader); len(values) > 0 {
+		scheme, token, found := strings.Cut(values[0], " ")
+		if !found || !strings.EqualFold(scheme, "bearer") {
+			return Identity{}, fmt.Errorf("%w: unsupported authorization scheme", ErrInvalidCredentials)
+		}
+		return authenticator.authenticateJWT(ctx, strings.TrimSpace(token))
+	}
+	if values := md.Get(APIKeyHeader); len(values) > 0 {
+		return authenticator.authenticateAPIKey(values[0])
+	}
+	return Identity{}, ErrMissingCredentials
+}
+
+func (authenticator *Authenticator) authenticateJWT(ctx context.Context, raw string) (Identity, error) {
+	claims := jwt.MapClaims{}
+	_, err := authenticator.parser.ParseWithClaims(raw, claims, func(token *jwt.Token) (any, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+			return authenticator.hmacSecret, nil
+		}
+		if authenticator.jwks == nil {
+			return nil, errors.New("no JWKS configured")
+		}
+		return authenticator.jwks.KeyfuncCtx(ctx)(token)
+	})
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	subject, err := claims.GetSubject()
+	if err != nil || subject == "" {
+		return Identity{}, fmt.Errorf("%w: token has no subject", ErrInvalidCredentials)
+	}
+	workspaceID, err := idClaim(claims, WorkspaceClaim)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	if workspaceID == 0 {
+		return Identity{}, fmt.Errorf("%w: token has no %s", ErrInvalidCredentials, WorkspaceClaim)
+	}
+	userID, err := idClaim(claims, UserClaim)
+	if err != nil {
+		return Identity{}, fmt.Errorf("%w: %w", ErrInvalidCredentials, err)
+	}
+	role, ok := claims[RoleClaim].(string)
+	if _, present := claims[RoleClaim]; present && !ok {
+		return Identity{}, fmt.Errorf("%w: %s must be a string", ErrInvalidCredentials, RoleClaim)
+	}
+	return Identity{Subject: subject, Method: MethodJWT, WorkspaceID: workspaceID, Role: role, UserID: userID, Claims: claims}, nil
+}
+
+// idClaim reads a positive ID sent either as a JSON number or a numeric string, it returns 0
+// when the claim is absent.
+func idClaim(claims jwt.MapClaims, name string) (int64, error) {
+	var id int64
+	switch value := claims[name].(type) {
+	case float64:
+		if value != float64(int64(value)) {
+			return 0, fmt.Errorf("%s %v is not an integer", name, value)
+		}
+		id = int64(value)
+	case string:
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s %q is not an integer", name, value)
+		}
+		id = parsed
+	case nil:
+		return 0, nil
+	default:
+		return 0, fmt.Errorf("%s has unsupported type %T", name, value)
+	}
+	if id <= 0 {
+		return 0, fmt.Errorf("%s must be positive", name)
+	}
+	return id, nil
+}
+
+func (authenticator *Authenticator) authenticateAPIKey(raw string) (Identity, error) {
+	hash := sha256.Sum256([]byte(raw))
+	key, ok := authenticator.apiKeys[hex.EncodeToString(hash[:])]
+	if !ok {
+		return Identity{}, fmt.Errorf("%w: unknown API key", ErrInvalidCredentials)
+	}
+	return Identity{Subject: key.Subject, Method: MethodAPIKey, WorkspaceID: key.WorkspaceID, Role: key.Role, UserID: key.UserID, Claims: map[string]any{}}, nil
+}
@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// authenticate resolves the caller of a non-exempt method, returning an Unauthenticated status
+// on failure. The reason is logged but not returned to the client.
+func (authenticator *Authenticator) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if authenticator.Exempt(method) {
+		return ctx, nil
+	}
+	identity, err := authenticator.Authenticate(ctx)
+	if err != nil {
+		slog.InfoContext(ctx, "authentication failed", "method", method, "error", err)
+		if errors.Is(err, ErrMissingCredentials) {
+			return nil, status.Error(codes.Unauthenticated, "missing credentials")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid credentials")
+	}
+	return WithIdentity(ctx, identity), nil
+}
+
+// UnaryServerInterceptor rejects unauthenticated calls and stores the caller's identity in the
+// context.
+func UnaryServerInterceptor(authenticator *Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authenticator.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects unauthenticated calls and stores the caller's identity in the
+// stream context.
+func StreamServerInterceptor(authenticator *Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticator.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &identityServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type identityServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *identityServerStream) Context() context.Context {
+	return stream.ctx
+}
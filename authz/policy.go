@@ -0,0 +1,137 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	"gopkg.in/yaml.v3"
+)
+
+// Scope is the set of reviewees whose ratings a role can read.
+type Scope string
+
+const (
+	// ScopeSelf only allows the caller's own ratings as a reviewee.
+	ScopeSelf Scope = "self"
+	// ScopeTeam allows the caller and the members of every team they lead.
+	ScopeTeam Scope = "team"
+	// ScopeAll allows every rating of the caller's workspace.
+	ScopeAll Scope = "all"
+)
+
+// AllMethods in a role's methods allows every RPC.
+const AllMethods = "*"
+
+type Role struct {
+	Scope Scope `yaml:"scope"`
+	// Methods are the RPC names, such as GetScoreByTicket, the role may call.
+	Methods []string `yaml:"methods"`
+}
+
+type Team struct {
+	Name        string  `yaml:"name"`
+	WorkspaceID int64   `yaml:"workspace_id"`
+	Leads       []int64 `yaml:"leads"`
+	Members     []int64 `yaml:"members"`
+}
+
+// Policy is the declarative authorization policy, loaded from a YAML file.
+type Policy struct {
+	Roles map[string]Role `yaml:"roles"`
+	Teams []Team          `yaml:"teams"`
+}
+
+// LoadPolicy reads and validates the policy file at path.
+func LoadPolicy(path string) (*Policy, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open policy file: %w", err)
+	}
+	defer file.Close()
+
+	policy := &Policy{}
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(policy); err != nil {
+		return nil, fmt.Errorf("failed to parse policy file %s: %w", path, err)
+	}
+	if err := policy.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid policy file %s: %w", path, err)
+	}
+	return policy, nil
+}
+
+// Validate checks that every role has a known scope and every team a workspace.
+func (policy *Policy) Validate() error {
+	var errs []error
+	if len(policy.Roles) == 0 {
+		errs = append(errs, errors.New("no roles defined"))
+	}
+	for name, role := range policy.Roles {
+		switch role.Scope {
+		case ScopeSelf, ScopeTeam, ScopeAll:
+		default:
+			errs = append(errs, fmt.Errorf("role %s: scope %q must be self, team or all", name, role.Scope))
+		}
+		if len(role.Methods) == 0 {
+			errs = append(errs, fmt.Errorf("role %s: no methods allowed", name))
+		}
+	}
+	for i, team := range policy.Teams {
+		if team.WorkspaceID <= 0 {
+			errs = append(errs, fmt.Errorf("team %d (%s): workspace_id must be set", i, team.Name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Restrict returns ctx restricted to the reviewees identity may read through method, or an
+// error wrapping apperror.ErrPermissionDenied.
+func (policy *Policy) Restrict(ctx context.Context, method string) (context.Context, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("%w: unauthenticated callers have no role", apperror.ErrPermissionDenied)
+	}
+	role, ok := policy.Roles[identity.Role]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown role %q", apperror.ErrPermissionDenied, identity.Role)
+	}
+	if !slices.Contains(role.Methods, AllMethods) && !slices.Contains(role.Methods, method) {
+		return nil, fmt.Errorf("%w: role %s may not call %s", apperror.ErrPermissionDenied, identity.Role, method)
+	}
+	if role.Scope == ScopeAll {
+		return ctx, nil
+	}
+	if identity.UserID == 0 {
+		return nil, fmt.Errorf("%w: role %s needs a user_id", apperror.ErrPermissionDenied, identity.Role)
+	}
+	reviewees := []int64{identity.UserID}
+	if role.Scope == ScopeTeam {
+		for _, team := range policy.Teams {
+			if team.WorkspaceID == identity.WorkspaceID && slices.Contains(team.Leads, identity.UserID) {
+				reviewees = append(reviewees, team.Members...)
+			}
+		}
+		slices.Sort(reviewees)
+		reviewees = slices.Compact(reviewees)
+	}
+	return WithReviewees(ctx, reviewees), nil
+}
+
+type revieweesKey struct{}
+
+// WithReviewees restricts the repositories to ratings of the given reviewees.
+func WithReviewees(ctx context.Context, reviewees []int64) context.Context {
+	return context.WithValue(ctx, revieweesKey{}, reviewees)
+}
+
+// Reviewees returns the reviewees ctx is restricted to, ok is false when it is unrestricted.
+func Reviewees(ctx context.Context) (reviewees []int64, ok bool) {
+	reviewees, ok = ctx.Value(revieweesKey{}).([]int64)
+	return reviewees, ok
+}
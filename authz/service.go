@@ -0,0 +1,80 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+)
+
+type scoreService interface {
+	GetScoreByTicket(ctx context.Context, from time.Time, to time.Time) ([]service.TicketScoreByCategory, error)
+	GetAggregatedCategoryScoresOverTime(ctx context.Context, from time.Time, to time.Time) ([]service.CategoryScoreOverTime, error)
+	GetOverAllQualityScore(ctx context.Context, from time.Time, to time.Time) (*float64, error)
+	GetPeriodOverPeriodScoreChange(ctx context.Context, from time.Time, to time.Time) (*service.GetPeriodOverPeriodScoreChangeResponse, error)
+	GetDashboard(ctx context.Context, from time.Time, to time.Time, sections service.DashboardSections) (*service.Dashboard, error)
+}
+
+// ScoreService applies the policy to every call before delegating to the wrapped service, so
+// the scores are only computed over the ratings the caller may read.
+type ScoreService struct {
+	policy *Policy
+	next   scoreService
+}
+
+func NewScoreService(policy *Policy, next scoreService) *ScoreService {
+	return &ScoreService{policy: policy, next: next}
+}
+
+func (scoreService *ScoreService) GetScoreByTicket(ctx context.Context, from time.Time, to time.Time) ([]service.TicketScoreByCategory, error) {
+	ctx, err := scoreService.policy.Restrict(ctx, "GetScoreByTicket")
+	if err != nil {
+		return nil, err
+	}
+	return scoreService.next.GetScoreByTicket(ctx, from, to)
+}
+
+func (scoreService *ScoreService) GetAggregatedCategoryScoresOverTime(ctx context.Context, from time.Time, to time.Time) ([]service.CategoryScoreOverTime, error) {
+	ctx, err := scoreService.policy.Restrict(ctx, "GetAggregatedCategoryScoresOverTime")
+	if err != nil {
+		return nil, err
+	}
+	return scoreService.next.GetAggregatedCategoryScoresOverTime(ctx, from, to)
+}
+
+func (scoreService *ScoreService) GetOverAllQualityScore(ctx context.Context, from time.Time, to time.Time) (*float64, error) {
+	ctx, err := scoreService.policy.Restrict(ctx, "GetOverAllQualityScore")
+	if err != nil {
+		return nil, err
+	}
+	return scoreService.next.GetOverAllQualityScore(ctx, from, to)
+}
+
+func (scoreService *ScoreService) GetPeriodOverPeriodScoreChange(ctx context.Context, from time.Time, to time.Time) (*service.GetPeriodOverPeriodScoreChangeResponse, error) {
+	ctx, err := scoreService.policy.Restrict(ctx, "GetPeriodOverPeriodScoreChange")
+	if err != nil {
+		return nil, err
+	}
+	return scoreService.next.GetPeriodOverPeriodScoreChange(ctx, from, to)
+}
+
+// GetDashboard needs the caller to be allowed every method whose data the requested sections
+// return, and at least one section so that the call is always restricted. Every method restricts
+// ctx to the same reviewees.
+func (scoreService *ScoreService) GetDashboard(ctx context.Context, from time.Time, to time.Time, sections service.DashboardSections) (*service.Dashboard, error) {
+	methods := sections.Methods()
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("%w: a dashboard without sections reads no method", apperror.ErrPermissionDenied)
+	}
+	var restricted context.Context
+	for _, method := range methods {
+		var err error
+		restricted, err = scoreService.policy.Restrict(ctx, method)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return scoreService.next.GetDashboard(restricted, from, to, sections)
+}
@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+const usage = `usage: scorectl <command> [flags] [range]
+
+Commands:
+  tickets     scores of each ticket by category (GetScoreByTicket)
+  categories  daily or weekly scores of each category (GetAggregatedCategoryScoresOverTime)
+  overall     overall quality score (GetOverAllQualityScore)
+  change      change over the previous period (GetPeriodOverPeriodScoreChange)
+  export      CSV or XLSX file of the categories, tickets or overall report (ExportReport)
+  health      serving status of the server, failing unless it is SERVING (grpc.health.v1.Health)
+
+The range is a day (2019-07-17), a month (2019-07), the first and last day of the range
+(2019-07-01..2019-07-31) or one of today, yesterday, this-week, last-week, this-month and
+last-month. It defaults to last-week.
+
+Run "scorectl <command> -h" for the flags.
+`
+
+// Commands maps each command to the RPC it calls.
+var Commands = map[string]string{
+	"tickets":    "GetScoreByTicket",
+	"categories": "GetAggregatedCategoryScoresOverTime",
+	"overall":    "GetOverAllQualityScore",
+	"change":     "GetPeriodOverPeriodScoreChange",
+	"export":     "ExportReport",
+	"health":     "Check",
+}
+
+// Run implements scorectl: it parses args, without the program name, calls the Scores server
+// and writes the result to stdout. lookupEnv provides the defaults of the connection flags.
+func Run(ctx context.Context, args []string, stdout io.Writer, lookupEnv func(string) (string, bool)) error {
+	if len(args) == 0 || Commands[args[0]] == "" {
+		return errors.New(usage)
+	}
+	command := args[0]
+	getEnv := func(key, defaultVal string) string {
+		if value, ok := lookupEnv(key); ok {
+			return value
+		}
+		return defaultVal
+	}
+
+	flags := flag.NewFlagSet("scorectl "+command, flag.ContinueOnError)
+	address := flags.String("address", getEnv("SCORES_ADDRESS", "localhost:9000"), "gRPC address of the server (SCORES_ADDRESS)")
+	rangeFlag := flags.String("range", "last-week", "range to query, see \"scorectl\" for the accepted forms")
+	var output, reportName, exportFormat, file, healthService *string
+	switch command {
+	case "health":
+		healthService = flags.String("service", "", "health service to check, such as liveness; the whole server by default")
+	case "export":
+		reportName = flags.String("report", "categories", "report to export: categories, tickets or overall")
+		exportFormat = flags.String("format", "csv", "file format: csv or xlsx")
+		file = flags.String("file", "", "file to write, - for stdout; the name chosen by the server by default")
+	default:
+		output = flags.String("output", FormatTable, "output format: table, json or csv")
+	}
+	token := flags.String("token", getEnv("SCORES_TOKEN", ""), "JWT sent as a bearer token (SCORES_TOKEN)")
+	apiKey := flags.String("api-key", getEnv("SCORES_API_KEY", ""), "API key (SCORES_API_KEY)")
+	useTLS := flags.Bool("tls", false, "connect with TLS")
+	caFile := flags.String("ca-file", "", "CA bundle verifying the server certificate, the system pool by default; implies -tls")
+	certFile := flags.String("cert-file", "", "client certificate for mutual TLS; implies -tls")
+	keyFile := flags.String("key-file", "", "private key of -cert-file")
+	serverName := flags.String("server-name", "", "name the server certificate is verified against, the host of -address by default; implies -tls")
+	timeout := flags.Duration("timeout", 30*time.Second, "deadline of the call")
+	// Flags may follow the range, which the flag package would otherwise stop at.
+	var positional []string
+	for remaining := args[1:]; ; remaining = flags.Args()[1:] {
+		if err := flags.Parse(remaining); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil
+			}
+			return err
+		}
+		if flags.NArg() == 0 {
+			break
+		}
+		positional = append(positional, flags.Arg(0))
+	}
+	if len(positional) > 1 {
+		return fmt.Errorf("unexpected arguments %v", positional[1:])
+	}
+	if len(positional) == 1 {
+		*rangeFlag = positional[0]
+	}
+	if output != nil && !isFormat(*output) {
+		return fmt.Errorf("unknown output format %q, expected table, json or csv", *output)
+	}
+	if reportName != nil && Reports[*reportName] == pb.ReportType_REPORT_TYPE_UNSPECIFIED {
+		return fmt.Errorf("unknown report %q, expected categories, tickets or overall", *reportName)
+	}
+	if exportFormat != nil && ExportFormats[*exportFormat] == pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		return fmt.Errorf("unknown file format %q, expected csv or xlsx", *exportFormat)
+	}
+	dateRange, err := util.ParseDateRange(*rangeFlag, time.Now())
+	if err != nil {
+		return err
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if *useTLS || *caFile != "" || *certFile != "" || *serverName != "" {
+		tlsConfig, err := clientTLSConfig(*caFile, *certFile, *keyFile, *serverName)
+		if err != nil {
+			return err
+		}
+		transportCredentials = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(*address, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, auth.AuthorizationHeader, "Bearer "+*token)
+	}
+	if *apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, auth.APIKeyHeader, *apiKey)
+	}
+	if command == "health" {
+		return Health(ctx, conn, *healthService, stdout)
+	}
+	client := pb.NewScoresClient(conn)
+	if command == "export" {
+		path, err := Export(ctx, client, Reports[*reportName], ExportFormats[*exportFormat], dateRange, *file, stdout)
+		if err == nil && path != "-" {
+			_, err = fmt.Fprintln(stdout, path)
+		}
+		return err
+	}
+	return Query(ctx, client, pbv2.NewScoresClient(conn), command, dateRange, *output, stdout)
+}
+
+func clientTLSConfig(caFile, certFile, keyFile, serverName string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: serverName}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", caFile)
+		}
+	}
+	if certFile != "" || keyFile != "" {
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	return tlsConfig, nil
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Reports maps the -report flag of the export command to the report it exports.
+var Reports = map[string]pb.ReportType{
+	"categories": pb.ReportType_REPORT_TYPE_CATEGORIES_OVER_TIME,
+	"tickets":    pb.ReportType_REPORT_TYPE_TICKETS,
+	"overall":    pb.ReportType_REPORT_TYPE_OVERALL,
+}
+
+// ExportFormats maps the -format flag of the export command to the file format.
+var ExportFormats = map[string]pb.ExportFormat{
+	"csv":  pb.ExportFormat_EXPORT_FORMAT_CSV,
+	"xlsx": pb.ExportFormat_EXPORT_FORMAT_XLSX,
+}
+
+// Export calls ExportReport and writes the file to path, or to stdout when path is "-". An empty
+// path uses the file name chosen by the server, in the current directory. It returns the path of
+// the file, which is removed if the export fails midway.
+func Export(ctx context.Context, client pb.ScoresClient, reportType pb.ReportType, format pb.ExportFormat, dateRange util.DateRange, path string, stdout io.Writer) (string, error) {
+	stream, err := client.ExportReport(ctx, &pb.ExportReportRequest{
+		From:   timestamppb.New(dateRange.From),
+		To:     timestamppb.New(dateRange.To),
+		Report: reportType,
+		Format: format,
+	})
+	if err != nil {
+		return "", err
+	}
+	// The file is only created once the first chunk arrives, so a rejected export leaves nothing.
+	chunk, err := stream.Recv()
+	if err != nil {
+		return "", err
+	}
+	if path == "-" {
+		return path, copyChunks(stdout, chunk, stream)
+	}
+	if path == "" {
+		path = filepath.Base(chunk.GetFileName())
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	err = copyChunks(file, chunk, stream)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return "", err
+	}
+	return path, nil
+}
+
+func copyChunks(w io.Writer, first *pb.ExportChunk, stream pb.Scores_ExportReportClient) error {
+	for chunk := first; ; {
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			return err
+		}
+		var err error
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
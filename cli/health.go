@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Health writes the serving status of service, the whole server when empty, to w and fails
+// unless it is SERVING, so it can be used as an exec probe.
+func Health(ctx context.Context, conn grpc.ClientConnInterface, service string, w io.Writer) error {
+	response, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, response.GetStatus()); err != nil {
+		return err
+	}
+	if response.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("service %q is %s", service, response.GetStatus())
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/report"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Output formats. Tables follow the UI representations of the task description, JSON uses the
+// gRPC JSON mapping with one message per line for streaming RPCs, like the REST gateway.
+const (
+	FormatTable = "table"
+	FormatJSON  = "json"
+	FormatCSV   = "csv"
+)
+
+func isFormat(format string) bool {
+	return format == FormatTable || format == FormatJSON || format == FormatCSV
+}
+
+// Query calls the RPC of command for dateRange and writes the result to w in format. The change
+// command calls v2, which leaves unavailable scores unset instead of sending 0.
+func Query(ctx context.Context, client pb.ScoresClient, clientV2 pbv2.ScoresClient, command string, dateRange util.DateRange, format string, w io.Writer) error {
+	request := &pb.DateRangeRequest{From: timestamppb.New(dateRange.From), To: timestamppb.New(dateRange.To)}
+	var messages []proto.Message
+	var table *report.Table
+	switch command {
+	case "tickets":
+		stream, err := client.GetScoreByTicket(ctx, request)
+		if err != nil {
+			return err
+		}
+		tickets, err := receiveAll(stream)
+		if err != nil {
+			return err
+		}
+		messages, table = toMessages(tickets), report.Tickets(tickets)
+	case "categories":
+		stream, err := client.GetAggregatedCategoryScoresOverTime(ctx, request)
+		if err != nil {
+			return err
+		}
+		categories, err := receiveAll(stream)
+		if err != nil {
+			return err
+		}
+		messages, table = toMessages(categories), report.CategoriesOverTime(categories)
+	case "overall":
+		response, err := client.GetOverAllQualityScore(ctx, request)
+		if err != nil {
+			return err
+		}
+		messages, table = []proto.Message{response}, report.Overall(dateRange.From, dateRange.To, response)
+	case "change":
+		response, err := clientV2.GetPeriodOverPeriodScoreChange(ctx, &pbv2.DateRangeRequest{From: request.From, To: request.To})
+		if err != nil {
+			return err
+		}
+		messages, table = []proto.Message{response}, report.PeriodOverPeriod(response)
+	default:
+		return fmt.Errorf("unknown command %q", command)
+	}
+
+	switch format {
+	case FormatJSON:
+		return writeJSON(w, messages)
+	case FormatCSV:
+		return table.WriteCSV(w)
+	default:
+		return writeTable(w, table)
+	}
+}
+
+func receiveAll[T any](stream grpc.ServerStreamingClient[T]) ([]*T, error) {
+	var messages []*T
+	for {
+		message, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return messages, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+}
+
+func toMessages[T proto.Message](values []T) []proto.Message {
+	messages := make([]proto.Message, len(values))
+	for i, value := range values {
+		messages[i] = value
+	}
+	return messages
+}
+
+func writeJSON(w io.Writer, messages []proto.Message) error {
+	for _, message := range messages {
+		line, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(message)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "%s\n", line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package cli
+
+import (
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fernandoalava/softwareengineer-test-task/report"
+)
+
+// writeTable writes the report as aligned columns.
+func writeTable(w io.Writer, table *report.Table) error {
+	writer := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, line := range table.Strings() {
+		if _, err := io.WriteString(writer, strings.Join(line, "\t")+"\n"); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
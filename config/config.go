@@ -0,0 +1,268 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/logging"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+)
+
+// Config is the effective configuration of the service. Every field can be set, from lowest to
+// highest precedence, by its default, the YAML file, the environment variable named in its env
+// tag and the command line flag named in its flag tag.
+type Config struct {
+	Listen    ListenConfig    `yaml:"listen"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Cache     CacheConfig     `yaml:"cache"`
+	Ranges    RangesConfig    `yaml:"ranges"`
+	Scores    ScoresConfig    `yaml:"scores"`
+	TLS       TLSConfig       `yaml:"tls"`
+	Auth      AuthConfig      `yaml:"auth"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	CORS      CORSConfig      `yaml:"cors"`
+	Logging   LoggingConfig   `yaml:"logging"`
+	Health    HealthConfig    `yaml:"health"`
+}
+
+type ListenConfig struct {
+	GRPCAddress     string        `yaml:"grpc_address" env:"GRPC_ADDRESS" flag:"grpc-address" usage:"gRPC listen address"`
+	MetricsAddress  string        `yaml:"metrics_address" env:"METRICS_ADDRESS" flag:"metrics-address" usage:"metrics HTTP listen address"`
+	HTTPAddress     string        `yaml:"http_address" env:"HTTP_ADDRESS" flag:"http-address" usage:"listen address of the REST/JSON, Connect and gRPC-Web endpoints, empty disables them"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" flag:"shutdown-timeout" usage:"time given to in-flight requests on shutdown"`
+	TrustedProxies  string        `yaml:"trusted_proxies" env:"TRUSTED_PROXIES" flag:"trusted-proxies" usage:"comma separated addresses or CIDR ranges of proxies whose X-Forwarded-For the HTTP endpoints rate limit by"`
+}
+
+// TrustedProxyList splits TrustedProxies.
+func (config ListenConfig) TrustedProxyList() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(config.TrustedProxies, ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
+
+type DatabaseConfig struct {
+	Driver          string        `yaml:"driver" env:"DB_DRIVER" flag:"db-driver" usage:"database/sql driver name"`
+	DSN             string        `yaml:"dsn" env:"DB_PATH" flag:"db-dsn" usage:"SQLite database file path, it must exist"`
+	ReadOnly        bool          `yaml:"read_only" env:"DB_READ_ONLY" flag:"db-read-only" usage:"open the database read-only"`
+	Immutable       bool          `yaml:"immutable" env:"DB_IMMUTABLE" flag:"db-immutable" usage:"promise the file never changes while open, skipping locking"`
+	JournalMode     string        `yaml:"journal_mode" env:"DB_JOURNAL_MODE" flag:"db-journal-mode" usage:"journal mode to set, such as wal, empty keeps the file's mode"`
+	BusyTimeout     time.Duration `yaml:"busy_timeout" env:"DB_BUSY_TIMEOUT" flag:"db-busy-timeout" usage:"how long to wait for a locked database"`
+	CacheSizeKiB    int           `yaml:"cache_size_kib" env:"DB_CACHE_SIZE_KIB" flag:"db-cache-size-kib" usage:"page cache size per connection in KiB, 0 keeps the SQLite default"`
+	MmapSize        int           `yaml:"mmap_size" env:"DB_MMAP_SIZE" flag:"db-mmap-size" usage:"bytes of the file to memory map, 0 disables mmap"`
+	MaxOpenConns    int           `yaml:"max_open_conns" env:"DB_MAX_OPEN_CONNS" flag:"db-max-open-conns" usage:"maximum open connections, 0 is unlimited"`
+	MaxIdleConns    int           `yaml:"max_idle_conns" env:"DB_MAX_IDLE_CONNS" flag:"db-max-idle-conns" usage:"maximum idle connections"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime" env:"DB_CONN_MAX_LIFETIME" flag:"db-conn-max-lifetime" usage:"maximum connection lifetime, 0 is unlimited"`
+}
+
+type CacheConfig struct {
+	RatingCategoriesTTL time.Duration `yaml:"rating_categories_ttl" env:"CACHE_RATING_CATEGORIES_TTL" flag:"cache-rating-categories-ttl" usage:"how long rating categories are cached, 0 disables the cache"`
+}
+
+type RangesConfig struct {
+	DefaultRange time.Duration `yaml:"default_range" env:"DEFAULT_RANGE" flag:"default-range" usage:"range length used when from is omitted"`
+	MaxRange     time.Duration `yaml:"max_range" env:"MAX_RANGE" flag:"max-range" usage:"longest accepted range, 0 is unlimited"`
+}
+
+type ScoresConfig struct {
+	CategoryOrder string `yaml:"category_order" env:"CATEGORY_ORDER" flag:"category-order" usage:"order of the categories in aggregated scores: id, name or weight (heaviest first)"`
+}
+
+type TLSConfig struct {
+	CertFile     string `yaml:"cert_file" env:"TLS_CERT_FILE" flag:"tls-cert-file" usage:"server certificate file, enables TLS"`
+	KeyFile      string `yaml:"key_file" env:"TLS_KEY_FILE" flag:"tls-key-file" usage:"server private key file"`
+	ClientCAFile string `yaml:"client_ca_file" env:"TLS_CLIENT_CA_FILE" flag:"tls-client-ca-file" usage:"CA bundle for client certificates, enables mutual TLS"`
+}
+
+type AuthConfig struct {
+	Enabled            bool   `yaml:"enabled" env:"AUTH_ENABLED" flag:"auth-enabled" usage:"require credentials for every non-exempt method"`
+	HMACSecretFile     string `yaml:"hmac_secret_file" env:"AUTH_HMAC_SECRET_FILE" flag:"auth-hmac-secret-file" usage:"file with the secret for HMAC signed JWTs"`
+	JWKSFile           string `yaml:"jwks_file" env:"AUTH_JWKS_FILE" flag:"auth-jwks-file" usage:"JSON Web Key Set file for asymmetrically signed JWTs"`
+	Issuer             string `yaml:"issuer" env:"AUTH_ISSUER" flag:"auth-issuer" usage:"required JWT issuer, empty accepts any"`
+	Audience           string `yaml:"audience" env:"AUTH_AUDIENCE" flag:"auth-audience" usage:"required JWT audience, empty accepts any"`
+	APIKeysFile        string `yaml:"api_keys_file" env:"AUTH_API_KEYS_FILE" flag:"auth-api-keys-file" usage:"YAML file with the SHA-256 of accepted API keys"`
+	ExemptMethods      string `yaml:"exempt_methods" env:"AUTH_EXEMPT_METHODS" flag:"auth-exempt-methods" usage:"comma separated methods or service prefixes ending in / served without credentials"`
+	DefaultWorkspaceID int    `yaml:"default_workspace_id" env:"AUTH_DEFAULT_WORKSPACE_ID" flag:"auth-default-workspace-id" usage:"workspace served when authentication is disabled"`
+	PolicyFile         string `yaml:"policy_file" env:"AUTH_POLICY_FILE" flag:"auth-policy-file" usage:"authorization policy restricting what each role can read, empty allows everything"`
+}
+
+// ExemptMethodList splits ExemptMethods.
+func (config AuthConfig) ExemptMethodList() []string {
+	var methods []string
+	for _, method := range strings.Split(config.ExemptMethods, ",") {
+		if method = strings.TrimSpace(method); method != "" {
+			methods = append(methods, method)
+		}
+	}
+	return methods
+}
+
+// RateLimitConfig costs are in days of ratings scanned, see ratelimit.EstimateCost.
+type RateLimitConfig struct {
+	CostPerSecond      int           `yaml:"cost_per_second" env:"RATE_LIMIT_COST_PER_SECOND" flag:"rate-limit-cost-per-second" usage:"cost refilled per client and second, 0 disables the per-client limit"`
+	Burst              int           `yaml:"burst" env:"RATE_LIMIT_BURST" flag:"rate-limit-burst" usage:"largest cost a client can spend at once"`
+	HeavyCost          int           `yaml:"heavy_cost" env:"RATE_LIMIT_HEAVY_COST" flag:"rate-limit-heavy-cost" usage:"cost from which a call is a heavy query"`
+	MaxConcurrentHeavy int           `yaml:"max_concurrent_heavy" env:"RATE_LIMIT_MAX_CONCURRENT_HEAVY" flag:"rate-limit-max-concurrent-heavy" usage:"heavy queries running at once, 0 is unlimited"`
+	MaxQueuedHeavy     int           `yaml:"max_queued_heavy" env:"RATE_LIMIT_MAX_QUEUED_HEAVY" flag:"rate-limit-max-queued-heavy" usage:"heavy queries waiting for a slot before new ones are rejected"`
+	QueueTimeout       time.Duration `yaml:"queue_timeout" env:"RATE_LIMIT_QUEUE_TIMEOUT" flag:"rate-limit-queue-timeout" usage:"how long a heavy query waits for a slot"`
+}
+
+// CORSConfig applies to the REST, Connect and gRPC-Web endpoints served on listen.http_address.
+type CORSConfig struct {
+	AllowedOrigins string        `yaml:"allowed_origins" env:"CORS_ALLOWED_ORIGINS" flag:"cors-allowed-origins" usage:"comma separated origins browsers may call the HTTP endpoints from, * allows any, empty disables CORS"`
+	MaxAge         time.Duration `yaml:"max_age" env:"CORS_MAX_AGE" flag:"cors-max-age" usage:"how long browsers may cache preflight responses"`
+}
+
+// AllowedOriginList splits AllowedOrigins.
+func (config CORSConfig) AllowedOriginList() []string {
+	var origins []string
+	for _, origin := range strings.Split(config.AllowedOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+type LoggingConfig struct {
+	Level              string        `yaml:"level" env:"LOG_LEVEL" flag:"log-level" usage:"minimum log level: debug, info, warn or error"`
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold" env:"SLOW_QUERY_THRESHOLD" flag:"slow-query-threshold" usage:"duration above which queries are logged"`
+}
+
+type HealthConfig struct {
+	CheckInterval time.Duration `yaml:"check_interval" env:"HEALTH_CHECK_INTERVAL" flag:"health-check-interval" usage:"interval between database health checks"`
+}
+
+var journalModes = map[string]bool{"": true, "delete": true, "truncate": true, "persist": true, "memory": true, "wal": true, "off": true}
+
+// Default returns the configuration used when nothing else is set.
+func Default() Config {
+	return Config{
+		Listen: ListenConfig{
+			GRPCAddress:     ":9000",
+			MetricsAddress:  ":9090",
+			HTTPAddress:     ":8080",
+			ShutdownTimeout: 30 * time.Second,
+		},
+		Database: DatabaseConfig{
+			Driver:       "sqlite",
+			ReadOnly:     true,
+			BusyTimeout:  5 * time.Second,
+			MaxOpenConns: 8,
+			MaxIdleConns: 8,
+		},
+		Cache: CacheConfig{
+			RatingCategoriesTTL: time.Minute,
+		},
+		Ranges: RangesConfig{
+			DefaultRange: 7 * 24 * time.Hour,
+		},
+		Scores: ScoresConfig{
+			CategoryOrder: string(service.CategoryOrderID),
+		},
+		Auth: AuthConfig{
+			DefaultWorkspaceID: 1,
+			ExemptMethods:      "/grpc.health.v1.Health/,/grpc.reflection.v1.ServerReflection/,/grpc.reflection.v1alpha.ServerReflection/",
+		},
+		RateLimit: RateLimitConfig{
+			CostPerSecond:      30,
+			Burst:              800,
+			HeavyCost:          180,
+			MaxConcurrentHeavy: 4,
+			MaxQueuedHeavy:     16,
+			QueueTimeout:       10 * time.Second,
+		},
+		CORS: CORSConfig{
+			MaxAge: 2 * time.Hour,
+		},
+		Logging: LoggingConfig{
+			Level:              "info",
+			SlowQueryThreshold: 500 * time.Millisecond,
+		},
+		Health: HealthConfig{
+			CheckInterval: 10 * time.Second,
+		},
+	}
+}
+
+// Validate returns every problem found in the configuration.
+func (config Config) Validate() error {
+	var problems []string
+	check := func(ok bool, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...))
+		}
+	}
+
+	check(config.Listen.GRPCAddress != "", "listen.grpc_address must be set")
+	check(config.Listen.MetricsAddress != "", "listen.metrics_address must be set")
+	check(config.Listen.GRPCAddress != config.Listen.MetricsAddress, "listen.grpc_address and listen.metrics_address must differ")
+	check(config.Listen.HTTPAddress == "" || (config.Listen.HTTPAddress != config.Listen.GRPCAddress && config.Listen.HTTPAddress != config.Listen.MetricsAddress),
+		"listen.http_address must differ from listen.grpc_address and listen.metrics_address")
+	check(config.Listen.ShutdownTimeout >= 0, "listen.shutdown_timeout must not be negative")
+	for _, proxy := range config.Listen.TrustedProxyList() {
+		_, errAddr := netip.ParseAddr(proxy)
+		_, errPrefix := netip.ParsePrefix(proxy)
+		check(errAddr == nil || errPrefix == nil, "listen.trusted_proxies entry %q must be an address or CIDR range", proxy)
+	}
+
+	check(config.Database.Driver == "sqlite", "database.driver %q is not supported, only sqlite is", config.Database.Driver)
+	check(config.Database.DSN != "", "database.dsn must be set (DB_PATH)")
+	check(config.Database.MaxOpenConns >= 0, "database.max_open_conns must not be negative")
+	check(config.Database.MaxIdleConns >= 0, "database.max_idle_conns must not be negative")
+	check(config.Database.ConnMaxLifetime >= 0, "database.conn_max_lifetime must not be negative")
+	check(!config.Database.Immutable || config.Database.ReadOnly, "database.immutable requires database.read_only")
+	check(journalModes[strings.ToLower(config.Database.JournalMode)], "database.journal_mode %q must be one of delete, truncate, persist, memory, wal or off", config.Database.JournalMode)
+	check(config.Database.JournalMode == "" || !config.Database.ReadOnly, "database.journal_mode can only be changed when database.read_only is false")
+	check(config.Database.BusyTimeout >= 0, "database.busy_timeout must not be negative")
+	check(config.Database.CacheSizeKiB >= 0, "database.cache_size_kib must not be negative")
+	check(config.Database.MmapSize >= 0, "database.mmap_size must not be negative")
+
+	check(config.Cache.RatingCategoriesTTL >= 0, "cache.rating_categories_ttl must not be negative")
+
+	check(config.Ranges.DefaultRange > 0, "ranges.default_range must be positive")
+	check(config.Ranges.MaxRange >= 0, "ranges.max_range must not be negative")
+	check(config.Ranges.MaxRange == 0 || config.Ranges.DefaultRange <= config.Ranges.MaxRange, "ranges.default_range must not be longer than ranges.max_range")
+
+	_, err := service.ParseCategoryOrder(config.Scores.CategoryOrder)
+	check(err == nil, "scores.category_order %q must be one of id, name or weight", config.Scores.CategoryOrder)
+
+	check((config.TLS.CertFile == "") == (config.TLS.KeyFile == ""), "tls.cert_file and tls.key_file must be set together")
+	check(config.TLS.ClientCAFile == "" || config.TLS.CertFile != "", "tls.client_ca_file requires tls.cert_file and tls.key_file")
+
+	check(!config.Auth.Enabled || config.Auth.HMACSecretFile != "" || config.Auth.JWKSFile != "" || config.Auth.APIKeysFile != "",
+		"auth.enabled requires auth.hmac_secret_file, auth.jwks_file or auth.api_keys_file")
+	check(config.Auth.DefaultWorkspaceID > 0, "auth.default_workspace_id must be positive")
+	check(config.Auth.PolicyFile == "" || config.Auth.Enabled, "auth.policy_file requires auth.enabled")
+	for _, method := range config.Auth.ExemptMethodList() {
+		check(strings.HasPrefix(method, "/"), "auth.exempt_methods entry %q must start with /", method)
+	}
+
+	check(config.RateLimit.CostPerSecond >= 0, "rate_limit.cost_per_second must not be negative")
+	check(config.RateLimit.CostPerSecond == 0 || config.RateLimit.Burst > 0, "rate_limit.burst must be positive")
+	check(config.RateLimit.MaxConcurrentHeavy >= 0, "rate_limit.max_concurrent_heavy must not be negative")
+	check(config.RateLimit.MaxConcurrentHeavy == 0 || config.RateLimit.HeavyCost > 0, "rate_limit.heavy_cost must be positive")
+	check(config.RateLimit.MaxQueuedHeavy >= 0, "rate_limit.max_queued_heavy must not be negative")
+	check(config.RateLimit.MaxConcurrentHeavy == 0 || config.RateLimit.QueueTimeout > 0, "rate_limit.queue_timeout must be positive")
+
+	for _, origin := range config.CORS.AllowedOriginList() {
+		check(origin == "*" || strings.HasPrefix(origin, "http://") || strings.HasPrefix(origin, "https://"), "cors.allowed_origins entry %q must be * or start with http:// or https://", origin)
+	}
+	check(config.CORS.MaxAge >= 0, "cors.max_age must not be negative")
+
+	_, err = logging.ParseLevel(config.Logging.Level)
+	check(err == nil, "logging.level %q must be one of debug, info, warn or error", config.Logging.Level)
+
+	check(config.Health.CheckInterval > 0, "health.check_interval must be positive")
+
+	if len(problems) > 0 {
+		return errors.New("invalid configuration:\n  " + strings.Join(problems, "\n  "))
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// legacyEnv maps the variables supported before the configuration file existed to the
+// environment variable which replaces them, e.g. PORT=9000 is read as GRPC_ADDRESS=:9000.
+var legacyEnv = map[string]struct {
+	env    string
+	format func(string) string
+}{
+	"PORT":         {env: "GRPC_ADDRESS", format: func(port string) string { return ":" + port }},
+	"METRICS_PORT": {env: "METRICS_ADDRESS", format: func(port string) string { return ":" + port }},
+}
+
+// Load builds the configuration from the defaults, the YAML file given with -config or
+// CONFIG_FILE, the environment and the command line flags, in increasing order of precedence,
+// and validates it.
+func Load(name string, args []string, lookupEnv func(string) (string, bool)) (Config, error) {
+	config := Default()
+
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	configFile := flags.String("config", "", "YAML configuration file")
+	// flagValues returns the raw value of every flag, booleans are registered as such so that
+	// -auth-enabled needs no =true.
+	flagValues := map[string]func() string{}
+	forEachField(reflect.ValueOf(&config).Elem(), func(field reflect.StructField, _ reflect.Value) {
+		name := field.Tag.Get("flag")
+		switch {
+		case name == "":
+		case field.Type.Kind() == reflect.Bool:
+			value := flags.Bool(name, false, field.Tag.Get("usage"))
+			flagValues[name] = func() string { return strconv.FormatBool(*value) }
+		default:
+			value := flags.String(name, "", field.Tag.Get("usage"))
+			flagValues[name] = func() string { return *value }
+		}
+	})
+	if err := flags.Parse(args); err != nil {
+		return Config{}, err
+	}
+	if flags.NArg() > 0 {
+		return Config{}, fmt.Errorf("unexpected arguments %v", flags.Args())
+	}
+
+	if *configFile == "" {
+		*configFile, _ = lookupEnv("CONFIG_FILE")
+	}
+	if *configFile != "" {
+		if err := loadFile(*configFile, &config); err != nil {
+			return Config{}, err
+		}
+	}
+
+	var errs []error
+	setFlags := map[string]bool{}
+	flags.Visit(func(f *flag.Flag) { setFlags[f.Name] = true })
+	forEachField(reflect.ValueOf(&config).Elem(), func(field reflect.StructField, value reflect.Value) {
+		if env := field.Tag.Get("env"); env != "" {
+			if raw, ok := lookupEnvWithLegacy(env, lookupEnv); ok {
+				errs = append(errs, setValue(value, raw, "environment variable "+env))
+			}
+		}
+		if name := field.Tag.Get("flag"); setFlags[name] {
+			errs = append(errs, setValue(value, flagValues[name](), "flag -"+name))
+		}
+	})
+	if err := errors.Join(errs...); err != nil {
+		return Config{}, err
+	}
+
+	return config, config.Validate()
+}
+
+func lookupEnvWithLegacy(env string, lookupEnv func(string) (string, bool)) (string, bool) {
+	if value, ok := lookupEnv(env); ok {
+		return value, true
+	}
+	for legacy, replacement := range legacyEnv {
+		if replacement.env != env {
+			continue
+		}
+		if value, ok := lookupEnv(legacy); ok {
+			return replacement.format(value), true
+		}
+	}
+	return "", false
+}
+
+func loadFile(path string, config *Config) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open configuration file: %w", err)
+	}
+	defer file.Close()
+
+	decoder := yaml.NewDecoder(file)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to parse configuration file %s: %w", path, err)
+	}
+	return nil
+}
+
+// forEachField calls fn for every leaf field of the configuration struct.
+func forEachField(value reflect.Value, fn func(field reflect.StructField, value reflect.Value)) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		if field.Type.Kind() == reflect.Struct {
+			forEachField(value.Field(i), fn)
+			continue
+		}
+		fn(field, value.Field(i))
+	}
+}
+
+func setValue(value reflect.Value, raw string, source string) error {
+	switch {
+	case value.Type() == durationType:
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q for %s", raw, source)
+		}
+		value.SetInt(int64(duration))
+	case value.Kind() == reflect.String:
+		value.SetString(raw)
+	case value.Kind() == reflect.Int:
+		number, err := strconv.Atoi(raw)
+		if err != nil {
+			return fmt.Errorf("invalid integer %q for %s", raw, source)
+		}
+		value.SetInt(int64(number))
+	case value.Kind() == reflect.Bool:
+		boolean, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q for %s", raw, source)
+		}
+		value.SetBool(boolean)
+	default:
+		return fmt.Errorf("unsupported type %s for %s", value.Type(), source)
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+package config
+
+import (
+	"io"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Print writes the configuration as YAML, in the same format as the configuration file.
+func Print(w io.Writer, config Config) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(config); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
@@ -11,10 +11,22 @@ type ScoreByTicket struct {
 	Score        float64
 }
 
+// ScoreByCategoryWithPeriod has a nil CategoryScore when the ratings of the period only belong to
+// categories weighing 0. WeightedRatingSum and WeightSum are the sums of rating * weight and of
+// weight over its ratings, which add up across periods.
 type ScoreByCategoryWithPeriod struct {
 	CategoryID        uint64
 	CategoryName      string
 	AggregationPeriod util.DateRange
-	CategoryScore     float64
+	CategoryScore     *float64
 	RatingsCount      int
+	WeightedRatingSum float64
+	WeightSum         float64
+}
+
+// TicketScore is the score of a ticket over all of its ratings, weighted by their category.
+type TicketScore struct {
+	TicketID     uint64
+	Score        float64
+	RatingsCount int
 }
@@ -0,0 +1,154 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1/scoresv1connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// connectScores serves the Scores RPCs over the Connect, gRPC-Web and gRPC protocols by calling
+// them through the same in-process connection as the REST gateway.
+type connectScores struct {
+	client pb.ScoresClient
+}
+
+// newConnectHandler returns the path prefix of the Scores service and its Connect handler.
+func newConnectHandler(conn *grpc.ClientConn) (string, http.Handler) {
+	return scoresv1connect.NewScoresHandler(&connectScores{client: pb.NewScoresClient(conn)})
+}
+
+// legacyConnectPath is the path prefix of the Scores service before it moved to scores.v1.
+const legacyConnectPath = "/grpc.Scores/"
+
+// newLegacyConnectHandler serves the Connect handler of scores.v1.Scores under the path prefix it
+// had as grpc.Scores, so browser clients generated from the old proto keep working.
+func newLegacyConnectHandler(handler http.Handler) (string, http.Handler) {
+	return legacyConnectPath, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.Clone(r.Context())
+		r.URL.Path = "/" + scoresv1connect.ScoresName + "/" + strings.TrimPrefix(r.URL.Path, legacyConnectPath)
+		r.URL.RawPath = ""
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func (scores *connectScores) GetScoreByTicket(ctx context.Context, request *connect.Request[pb.DateRangeRequest], stream *connect.ServerStream[pb.ScoreByTicket]) error {
+	client, err := scores.client.GetScoreByTicket(outgoingContext(ctx, request.Header()), request.Msg)
+	if err != nil {
+		return connectError(err, nil)
+	}
+	return forwardStream(client, stream)
+}
+
+func (scores *connectScores) GetAggregatedCategoryScoresOverTime(ctx context.Context, request *connect.Request[pb.DateRangeRequest], stream *connect.ServerStream[pb.CategoryScoreOverTime]) error {
+	client, err := scores.client.GetAggregatedCategoryScoresOverTime(outgoingContext(ctx, request.Header()), request.Msg)
+	if err != nil {
+		return connectError(err, nil)
+	}
+	return forwardStream(client, stream)
+}
+
+func (scores *connectScores) ExportReport(ctx context.Context, request *connect.Request[pb.ExportReportRequest], stream *connect.ServerStream[pb.ExportChunk]) error {
+	client, err := scores.client.ExportReport(outgoingContext(ctx, request.Header()), request.Msg)
+	if err != nil {
+		return connectError(err, nil)
+	}
+	return forwardStream(client, stream)
+}
+
+func (scores *connectScores) GetOverAllQualityScore(ctx context.Context, request *connect.Request[pb.DateRangeRequest]) (*connect.Response[pb.OverAllQualityScoreResponse], error) {
+	var header, trailer metadata.MD
+	response, err := scores.client.GetOverAllQualityScore(outgoingContext(ctx, request.Header()), request.Msg, grpc.Header(&header), grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, connectError(err, metadata.Join(header, trailer))
+	}
+	connectResponse := connect.NewResponse(response)
+	copyOutgoingHeaders(connectResponse.Header(), header)
+	return connectResponse, nil
+}
+
+func (scores *connectScores) GetPeriodOverPeriodScoreChange(ctx context.Context, request *connect.Request[pb.DateRangeRequest]) (*connect.Response[pb.GetPeriodOverPeriodScoreChangeResponse], error) {
+	var header, trailer metadata.MD
+	response, err := scores.client.GetPeriodOverPeriodScoreChange(outgoingContext(ctx, request.Header()), request.Msg, grpc.Header(&header), grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, connectError(err, metadata.Join(header, trailer))
+	}
+	connectResponse := connect.NewResponse(response)
+	copyOutgoingHeaders(connectResponse.Header(), header)
+	return connectResponse, nil
+}
+
+// forwardStream copies the messages of a gRPC server stream to a Connect one. The response
+// headers are copied before the first message, which is when Connect sends them.
+func forwardStream[T any](client grpc.ServerStreamingClient[T], stream *connect.ServerStream[T]) error {
+	header, err := client.Header()
+	if err == nil {
+		copyOutgoingHeaders(stream.ResponseHeader(), header)
+	}
+	for {
+		message, err := client.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return connectError(err, metadata.Join(header, client.Trailer()))
+		}
+		if err := stream.Send(message); err != nil {
+			return err
+		}
+	}
+}
+
+// outgoingContext forwards the credentials and request ID of an HTTP request as gRPC metadata,
+// like the REST gateway does.
+func outgoingContext(ctx context.Context, header http.Header) context.Context {
+	md := metadata.MD{}
+	for _, value := range header.Values(auth.AuthorizationHeader) {
+		md.Append(auth.AuthorizationHeader, value)
+	}
+	for key, name := range incomingHeaders {
+		for _, value := range header.Values(key) {
+			md.Append(name, value)
+		}
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+func copyOutgoingHeaders(header http.Header, md metadata.MD) {
+	for key := range outgoingHeaders {
+		for _, value := range md.Get(key) {
+			header.Add(key, value)
+		}
+	}
+}
+
+// connectError converts the status returned by the gRPC server, keeping its code, message,
+// details and the headers clients act on.
+func connectError(err error, md metadata.MD) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return connect.NewError(connect.CodeUnknown, err)
+	}
+	connectErr := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, detail := range st.Details() {
+		message, ok := detail.(proto.Message)
+		if !ok {
+			continue
+		}
+		if errorDetail, err := connect.NewErrorDetail(message); err == nil {
+			connectErr.AddDetail(errorDetail)
+		}
+	}
+	copyOutgoingHeaders(connectErr.Meta(), md)
+	return connectErr
+}
@@ -0,0 +1,71 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2/scoresv2connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// connectScoresV2 serves the scores.v2.Scores RPCs like connectScores serves the v1 ones.
+type connectScoresV2 struct {
+	client pbv2.ScoresClient
+}
+
+// newConnectHandlerV2 returns the path prefix of the v2 Scores service and its Connect handler.
+func newConnectHandlerV2(conn *grpc.ClientConn) (string, http.Handler) {
+	return scoresv2connect.NewScoresHandler(&connectScoresV2{client: pbv2.NewScoresClient(conn)})
+}
+
+func (scores *connectScoresV2) GetScoreByTicket(ctx context.Context, request *connect.Request[pbv2.DateRangeRequest], stream *connect.ServerStream[pbv2.ScoreByTicket]) error {
+	client, err := scores.client.GetScoreByTicket(outgoingContext(ctx, request.Header()), request.Msg)
+	if err != nil {
+		return connectError(err, nil)
+	}
+	return forwardStream(client, stream)
+}
+
+func (scores *connectScoresV2) GetAggregatedCategoryScoresOverTime(ctx context.Context, request *connect.Request[pbv2.DateRangeRequest], stream *connect.ServerStream[pbv2.CategoryScoreOverTime]) error {
+	client, err := scores.client.GetAggregatedCategoryScoresOverTime(outgoingContext(ctx, request.Header()), request.Msg)
+	if err != nil {
+		return connectError(err, nil)
+	}
+	return forwardStream(client, stream)
+}
+
+func (scores *connectScoresV2) GetOverAllQualityScore(ctx context.Context, request *connect.Request[pbv2.DateRangeRequest]) (*connect.Response[pbv2.OverAllQualityScoreResponse], error) {
+	var header, trailer metadata.MD
+	response, err := scores.client.GetOverAllQualityScore(outgoingContext(ctx, request.Header()), request.Msg, grpc.Header(&header), grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, connectError(err, metadata.Join(header, trailer))
+	}
+	connectResponse := connect.NewResponse(response)
+	copyOutgoingHeaders(connectResponse.Header(), header)
+	return connectResponse, nil
+}
+
+func (scores *connectScoresV2) GetPeriodOverPeriodScoreChange(ctx context.Context, request *connect.Request[pbv2.DateRangeRequest]) (*connect.Response[pbv2.GetPeriodOverPeriodScoreChangeResponse], error) {
+	var header, trailer metadata.MD
+	response, err := scores.client.GetPeriodOverPeriodScoreChange(outgoingContext(ctx, request.Header()), request.Msg, grpc.Header(&header), grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, connectError(err, metadata.Join(header, trailer))
+	}
+	connectResponse := connect.NewResponse(response)
+	copyOutgoingHeaders(connectResponse.Header(), header)
+	return connectResponse, nil
+}
+
+func (scores *connectScoresV2) GetDashboard(ctx context.Context, request *connect.Request[pbv2.DashboardRequest]) (*connect.Response[pbv2.DashboardResponse], error) {
+	var header, trailer metadata.MD
+	response, err := scores.client.GetDashboard(outgoingContext(ctx, request.Header()), request.Msg, grpc.Header(&header), grpc.Trailer(&trailer))
+	if err != nil {
+		return nil, connectError(err, metadata.Join(header, trailer))
+	}
+	connectResponse := connect.NewResponse(response)
+	copyOutgoingHeaders(connectResponse.Header(), header)
+	return connectResponse, nil
+}
@@ -0,0 +1,258 @@
+package gateway
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/textproto"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	"github.com/fernandoalava/softwareengineer-test-task/logging"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/ratelimit"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/cors"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// NDJSONContentType is returned by server streaming RPCs, one JSON object per line.
+	NDJSONContentType = "application/x-ndjson"
+	// OpenAPIPath serves the OpenAPI document generated from the HTTP annotations of scores.proto.
+	OpenAPIPath = "/openapi.json"
+	// OpenAPIV2Path serves the OpenAPI document of v2/scores.proto.
+	OpenAPIV2Path = "/v2/openapi.json"
+)
+
+var (
+	//go:embed scores/v1/scores.swagger.json
+	openAPIDocument []byte
+	//go:embed scores/v2/scores.swagger.json
+	openAPIV2Document []byte
+)
+
+// incomingHeaders are passed to the gRPC server as metadata in addition to Authorization, the
+// W3C trace-context ones so its spans continue the client's trace, and outgoingHeaders returned
+// to clients without the Grpc-Metadata- prefix.
+var (
+	incomingHeaders = map[string]string{
+		"Traceparent": "traceparent",
+		"Tracestate":  "tracestate",
+		textproto.CanonicalMIMEHeaderKey(auth.APIKeyHeader):             auth.APIKeyHeader,
+		textproto.CanonicalMIMEHeaderKey(logging.RequestIDHeader):       logging.RequestIDHeader,
+		textproto.CanonicalMIMEHeaderKey(ratelimit.ClientAddressHeader): ratelimit.ClientAddressHeader,
+	}
+	outgoingHeaders = map[string]bool{
+		logging.RequestIDHeader:   true,
+		apperror.RetryAfterHeader: true,
+	}
+)
+
+// ndjsonMarshaler renders messages as the gRPC JSON mapping and labels server streams, which the
+// gateway writes as one {"result": ...} or {"error": ...} object per line, as NDJSON.
+type ndjsonMarshaler struct {
+	runtime.JSONPb
+}
+
+func (ndjsonMarshaler) StreamContentType(interface{}) string {
+	return NDJSONContentType
+}
+
+// Options configures how browsers may use the handler.
+type Options struct {
+	// AllowedOrigins may call the handler from a browser, "*" allows any; CORS is disabled when
+	// empty.
+	AllowedOrigins []string
+	// CORSMaxAge is how long browsers may cache the response to a preflight request.
+	CORSMaxAge time.Duration
+	// TrustedProxies are the addresses or CIDR ranges of proxies whose X-Forwarded-For header
+	// names the client calls are rate limited as. Other callers are limited by their own address.
+	TrustedProxies []string
+}
+
+// Headers browsers may send and read, on top of the CORS safelisted ones, including those of
+// the Connect and gRPC-Web protocols.
+var (
+	corsAllowedHeaders = []string{
+		"Content-Type", "Connect-Protocol-Version", "Connect-Timeout-Ms", "Grpc-Timeout", "X-Grpc-Web", "X-User-Agent",
+		"Authorization", auth.APIKeyHeader, logging.RequestIDHeader, "Traceparent", "Tracestate",
+	}
+	corsExposedHeaders = []string{
+		"Grpc-Status", "Grpc-Message", "Grpc-Status-Details-Bin",
+		apperror.RetryAfterHeader, logging.RequestIDHeader,
+	}
+)
+
+// NewHandler serves the Scores RPCs by calling them through conn: as HTTP/JSON under /v1/, with
+// the OpenAPI document at OpenAPIPath, and over the Connect, gRPC-Web and gRPC protocols under
+// /scores.v1.Scores/, and /grpc.Scores/ for clients of the unversioned package. The v2 RPCs are
+// served the same way under /v2/, OpenAPIV2Path and /scores.v2.Scores/. It accepts HTTP/1.1 and HTTP/2, including cleartext HTTP/2 (h2c) when it is
+// not served with TLS.
+func NewHandler(ctx context.Context, conn *grpc.ClientConn, options Options) (http.Handler, error) {
+	trustedProxies, err := ParseTrustedProxies(options.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	gatewayMux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &ndjsonMarshaler{JSONPb: runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}}),
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+	)
+	if err := pb.RegisterScoresHandler(ctx, gatewayMux, conn); err != nil {
+		return nil, err
+	}
+	if err := pbv2.RegisterScoresHandler(ctx, gatewayMux, conn); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", gatewayMux)
+	mux.Handle("/v2/", gatewayMux)
+	mux.HandleFunc("GET "+OpenAPIPath, serveDocument(openAPIDocument))
+	mux.HandleFunc("GET "+OpenAPIV2Path, serveDocument(openAPIV2Document))
+	connectPath, connectHandler := newConnectHandler(conn)
+	mux.Handle(connectPath, connectHandler)
+	mux.Handle(newLegacyConnectHandler(connectHandler))
+	mux.Handle(newConnectHandlerV2(conn))
+
+	var handler http.Handler = withClientAddress(mux, trustedProxies)
+	if len(options.AllowedOrigins) > 0 {
+		handler = cors.New(cors.Options{
+			AllowedOrigins: options.AllowedOrigins,
+			AllowedMethods: []string{http.MethodGet, http.MethodPost},
+			AllowedHeaders: corsAllowedHeaders,
+			ExposedHeaders: corsExposedHeaders,
+			MaxAge:         int(options.CORSMaxAge.Seconds()),
+		}).Handler(handler)
+	}
+	return h2c.NewHandler(handler, &http2.Server{}), nil
+}
+
+// ParseTrustedProxies parses addresses and CIDR ranges, such as 10.0.0.1 or 10.0.0.0/8.
+func ParseTrustedProxies(proxies []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(proxies))
+	for _, proxy := range proxies {
+		if address, err := netip.ParseAddr(proxy); err == nil {
+			prefixes = append(prefixes, netip.PrefixFrom(address, address.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+	return prefixes, nil
+}
+
+// withClientAddress replaces the ClientAddressHeader sent by the client, also under the
+// Grpc-Metadata- prefix, with its actual address, so the in-process server limits every HTTP
+// client separately.
+func withClientAddress(handler http.Handler, trustedProxies []netip.Prefix) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r = r.Clone(r.Context())
+		r.Header.Del(runtime.MetadataHeaderPrefix + ratelimit.ClientAddressHeader)
+		r.Header.Set(ratelimit.ClientAddressHeader, clientAddress(r, trustedProxies))
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// clientAddress is the remote address of r or, when that is a trusted proxy, the last address in
+// X-Forwarded-For that is not one, since earlier entries could have been sent by the client.
+func clientAddress(r *http.Request, trustedProxies []netip.Prefix) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	trusted := func(address string) bool {
+		parsed, err := netip.ParseAddr(address)
+		if err != nil {
+			return false
+		}
+		parsed = parsed.Unmap()
+		return slices.ContainsFunc(trustedProxies, func(prefix netip.Prefix) bool {
+			return prefix.Contains(parsed)
+		})
+	}
+	if !trusted(host) {
+		return host
+	}
+	var forwarded []string
+	for _, value := range r.Header.Values("X-Forwarded-For") {
+		for _, address := range strings.Split(value, ",") {
+			forwarded = append(forwarded, strings.TrimSpace(address))
+		}
+	}
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		if forwarded[i] == "" {
+			break
+		}
+		if !trusted(forwarded[i]) {
+			return forwarded[i]
+		}
+		host = forwarded[i]
+	}
+	return host
+}
+
+func serveDocument(document []byte) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(document)
+	}
+}
+
+func incomingHeader(key string) (string, bool) {
+	if name, ok := incomingHeaders[textproto.CanonicalMIMEHeaderKey(key)]; ok {
+		return name, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func outgoingHeader(key string) (string, bool) {
+	if outgoingHeaders[key] {
+		return key, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// DialInProcess serves grpcServer on an in-memory listener and returns a connection to it, so the
+// gateway goes through the same interceptors as gRPC clients without a network hop. grpcServer
+// must not use transport credentials. stop closes the connection and stops the server.
+func DialInProcess(grpcServer *grpc.Server) (conn *grpc.ClientConn, stop func(), err error) {
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	conn, err = grpc.NewClient("passthrough:///in-process",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		grpcServer.Stop()
+		return nil, nil, err
+	}
+	stop = func() {
+		_ = conn.Close()
+		grpcServer.GracefulStop()
+	}
+	return conn, stop, nil
+}
@@ -0,0 +1,81 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// LivenessService is always SERVING while the process is able to answer, it is meant for
+// liveness probes which must not restart the pod because the database is unavailable.
+const LivenessService = "liveness"
+
+// Checker keeps the readiness of the registered services in sync with the database: they are
+// NOT_SERVING until the database is reachable and its schema is valid.
+type Checker struct {
+	conn         *sql.DB
+	healthServer *health.Server
+	interval     time.Duration
+	services     []string
+}
+
+func NewChecker(conn *sql.DB, healthServer *health.Server, interval time.Duration, services ...string) *Checker {
+	checker := &Checker{
+		conn:         conn,
+		healthServer: healthServer,
+		interval:     interval,
+		services:     append([]string{""}, services...),
+	}
+	healthServer.SetServingStatus(LivenessService, healthpb.HealthCheckResponse_SERVING)
+	checker.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return checker
+}
+
+func (checker *Checker) setStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range checker.services {
+		checker.healthServer.SetServingStatus(service, status)
+	}
+}
+
+// Check pings the database, verifies the schema and updates the serving status accordingly.
+func (checker *Checker) Check(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, checker.interval)
+	defer cancel()
+
+	err := checker.conn.PingContext(ctx)
+	if err == nil {
+		err = repository.VerifySchema(ctx, checker.conn)
+	}
+	if err != nil {
+		checker.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+		return err
+	}
+	checker.setStatus(healthpb.HealthCheckResponse_SERVING)
+	return nil
+}
+
+// Run checks the database every interval until ctx is done.
+func (checker *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(checker.interval)
+	defer ticker.Stop()
+	healthy := true
+	for {
+		err := checker.Check(ctx)
+		if err != nil && healthy {
+			slog.ErrorContext(ctx, "database health check failed", "error", err)
+		} else if err == nil && !healthy {
+			slog.InfoContext(ctx, "database health check recovered")
+		}
+		healthy = err == nil
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -60,3 +60,27 @@ Create the name of the service account to use
 {{- default "default" .Values.serviceAccount.name }}
 {{- end }}
 {{- end }}
+
+{{/*
+Probe checking the grpc.health.v1 service .service on the gRPC port. Kubernetes gRPC probes
+cannot use TLS, so with TLS enabled scorectl checks it instead.
+*/}}
+{{- define "score-service.probe" -}}
+{{- if .root.Values.tls.enabled -}}
+exec:
+  command:
+    - /go/bin/scorectl
+    - health
+    - -address=localhost:{{ .root.Values.service.port }}
+    - -ca-file=/etc/scores/tls/ca.crt
+    - -server-name={{ required "tls.serverName is required with tls.enabled" .root.Values.tls.serverName }}
+    - -service={{ .service }}
+    - -timeout=1s
+{{- else -}}
+grpc:
+  port: {{ .root.Values.service.port }}
+  {{- with .service }}
+  service: {{ . }}
+  {{- end }}
+{{- end }}
+{{- end }}
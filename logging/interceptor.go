@@ -0,0 +1,99 @@
+package logging
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader is the metadata key used to receive and return the request ID.
+const RequestIDHeader = "x-request-id"
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// maxRequestIDLength bounds the client request IDs that are logged and echoed back.
+const maxRequestIDLength = 128
+
+// validRequestID accepts IDs of at most maxRequestIDLength letters, digits and -_.:, which
+// covers UUIDs and trace IDs without letting clients inject anything else into the logs.
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, c := range id {
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || strings.ContainsRune("-_.:", c)) {
+			return false
+		}
+	}
+	return true
+}
+
+// requestID reuses the request ID sent by the client, when valid, or generates a new one.
+func requestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(RequestIDHeader); len(values) > 0 && validRequestID(values[0]) {
+		return values[0]
+	}
+	return newRequestID()
+}
+
+func logRPC(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	if err != nil {
+		level = slog.LevelWarn
+	}
+	slog.Log(ctx, level, "rpc finished", "method", method, "code", code.String(), "duration", time.Since(start))
+}
+
+// UnaryServerInterceptor injects the request ID into the context and the response header
+// metadata, and logs every finished RPC.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		id := requestID(ctx)
+		ctx = WithRequestID(ctx, id)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id)); err != nil {
+			slog.WarnContext(ctx, "failed to set request id header", "error", err)
+		}
+		resp, err := handler(ctx, req)
+		logRPC(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor injects the request ID into the context and the response header
+// metadata, and logs every finished RPC.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		id := requestID(ss.Context())
+		ctx := WithRequestID(ss.Context(), id)
+		if err := ss.SetHeader(metadata.Pairs(RequestIDHeader, id)); err != nil {
+			slog.WarnContext(ctx, "failed to set request id header", "error", err)
+		}
+		err := handler(srv, &requestIDServerStream{ServerStream: ss, ctx: ctx})
+		logRPC(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+type requestIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *requestIDServerStream) Context() context.Context {
+	return stream.ctx
+}
@@ -0,0 +1,57 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID, which is then added to every
+// record logged with that context.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID returns the request ID carried by ctx, if any.
+func RequestID(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey{}).(string)
+	return requestID, ok
+}
+
+// contextHandler adds the request ID found in the context to the records.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (handler contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID, ok := RequestID(ctx); ok {
+		record.AddAttrs(slog.String("request_id", requestID))
+	}
+	return handler.Handler.Handle(ctx, record)
+}
+
+func (handler contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{handler.Handler.WithAttrs(attrs)}
+}
+
+func (handler contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{handler.Handler.WithGroup(name)}
+}
+
+// ParseLevel parses one of debug, info, warn or error.
+func ParseLevel(value string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(strings.ToUpper(value))); err != nil {
+		return 0, fmt.Errorf("invalid log level %q", value)
+	}
+	return level, nil
+}
+
+// NewLogger returns a JSON logger writing records of at least the given level to w.
+func NewLogger(w io.Writer, level slog.Level) *slog.Logger {
+	return slog.New(contextHandler{slog.NewJSONHandler(w, &slog.HandlerOptions{Level: level})})
+}
@@ -1,59 +1,317 @@
 package main
 
 import (
-	"database/sql"
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
-	pb "github.com/fernandoalava/softwareengineer-test-task/grpc"
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	"github.com/fernandoalava/softwareengineer-test-task/authz"
+	"github.com/fernandoalava/softwareengineer-test-task/config"
+	"github.com/fernandoalava/softwareengineer-test-task/gateway"
+	"github.com/fernandoalava/softwareengineer-test-task/health"
+	"github.com/fernandoalava/softwareengineer-test-task/logging"
+	"github.com/fernandoalava/softwareengineer-test-task/metrics"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/ratelimit"
 	"github.com/fernandoalava/softwareengineer-test-task/repository"
 	"github.com/fernandoalava/softwareengineer-test-task/server"
 	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/tracing"
 	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
-var (
-	port     = util.GetEnv("PORT", "9000")
-	database = util.GetEnv("DB_PATH", "")
-)
-
 func main() {
-	log.Printf("trying to listen on port %s", port)
-	listener, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
+	var err error
+	if len(os.Args) > 2 && os.Args[1] == "config" && os.Args[2] == "print" {
+		err = printConfig(os.Args[3:])
+	} else if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err = migrate(os.Args[2:])
+	} else {
+		err = run(os.Args[1:])
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// printConfig implements the "config print" subcommand, showing the effective configuration
+// for the given flags, environment and configuration file.
+func printConfig(args []string) error {
+	cfg, err := config.Load("config print", args, os.LookupEnv)
+	if err != nil {
+		return err
+	}
+	return config.Print(os.Stdout, cfg)
+}
+
+// migrate implements the "migrate" subcommand, bringing the database schema up to date. It
+// always opens the database writable, whatever database.read_only says.
+func migrate(args []string) error {
+	cfg, err := config.Load("migrate", args, os.LookupEnv)
+	if err != nil {
+		return err
+	}
+	db, err := repository.OpenSQLite(context.Background(), cfg.Database.DSN, repository.SQLiteOptions{
+		JournalMode: cfg.Database.JournalMode,
+		BusyTimeout: cfg.Database.BusyTimeout,
+	})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return repository.Migrate(context.Background(), db)
+}
+
+func run(args []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	cfg, err := config.Load(os.Args[0], args, os.LookupEnv)
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		return err
 	}
-	if len(database) == 0 {
-		log.Fatalf("DB_PATH is empty or undefined")
+
+	level, _ := logging.ParseLevel(cfg.Logging.Level)
+	slog.SetDefault(logging.NewLogger(os.Stdout, level))
+	repository.SlowQueryThreshold = cfg.Logging.SlowQueryThreshold
+
+	slog.Info("trying to listen", "address", cfg.Listen.GRPCAddress)
+	listener, err := net.Listen("tcp", cfg.Listen.GRPCAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
 	}
-	log.Printf("loading database %s", database)
-	db, err := sql.Open("sqlite", database)
+	slog.Info("loading database", "driver", cfg.Database.Driver, "dsn", cfg.Database.DSN, "read_only", cfg.Database.ReadOnly)
+	db, err := repository.OpenSQLite(ctx, cfg.Database.DSN, repository.SQLiteOptions{
+		ReadOnly:     cfg.Database.ReadOnly,
+		Immutable:    cfg.Database.Immutable,
+		JournalMode:  cfg.Database.JournalMode,
+		BusyTimeout:  cfg.Database.BusyTimeout,
+		CacheSizeKiB: cfg.Database.CacheSizeKiB,
+		MmapSize:     cfg.Database.MmapSize,
+	})
 	if err != nil {
-		log.Fatal(err)
+		return err
+	}
+	db.SetMaxOpenConns(cfg.Database.MaxOpenConns)
+	db.SetMaxIdleConns(cfg.Database.MaxIdleConns)
+	db.SetConnMaxLifetime(cfg.Database.ConnMaxLifetime)
+	if err := repository.VerifySchema(ctx, db); err != nil {
+		return err
 	}
-	log.Printf("database %s loaded successfully", database)
+	slog.Info("database loaded successfully", "dsn", cfg.Database.DSN)
 	defer func() {
 		err := db.Close()
 		if err != nil {
-			log.Fatal("got error when closing the DB connection", err)
+			slog.Error("got error when closing the DB connection", "error", err)
+		}
+	}()
+
+	shutdownTracing, err := tracing.Setup(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := shutdownTracing(context.Background())
+		if err != nil {
+			slog.Error("got error when flushing traces", "error", err)
 		}
 	}()
 
-	ratingCategoryRepository := repository.NewRatingCategoryRepository(db)
+	if err := metrics.RegisterDB(db, "scores"); err != nil {
+		return err
+	}
+
+	var ratingCategoryRepository service.RatingCategoryRepository = repository.NewRatingCategoryRepository(db)
+	if cfg.Cache.RatingCategoriesTTL > 0 {
+		ratingCategoryRepository = repository.NewCachedRatingCategoryRepository(ratingCategoryRepository, cfg.Cache.RatingCategoriesTTL)
+	}
 	scoreRepository := repository.NewScoreRepository(db)
 
-	scoreService := service.NewScoreService(ratingCategoryRepository, scoreRepository)
+	categoryOrder, _ := service.ParseCategoryOrder(cfg.Scores.CategoryOrder)
+	var scoreService server.ScoreService = service.NewScoreService(ratingCategoryRepository, scoreRepository).
+		WithCategoryOrder(categoryOrder).
+		WithSnapshots(repository.NewSnapshots(db))
+	if cfg.Auth.PolicyFile != "" {
+		policy, err := authz.LoadPolicy(cfg.Auth.PolicyFile)
+		if err != nil {
+			return err
+		}
+		scoreService = authz.NewScoreService(policy, scoreService)
+	}
+	if cfg.RateLimit.CostPerSecond > 0 || cfg.RateLimit.MaxConcurrentHeavy > 0 {
+		scoreService = ratelimit.NewScoreService(ratelimit.NewLimiter(ratelimit.Options{
+			CostPerSecond:      cfg.RateLimit.CostPerSecond,
+			Burst:              cfg.RateLimit.Burst,
+			HeavyCost:          cfg.RateLimit.HeavyCost,
+			MaxConcurrentHeavy: cfg.RateLimit.MaxConcurrentHeavy,
+			MaxQueuedHeavy:     cfg.RateLimit.MaxQueuedHeavy,
+			QueueTimeout:       cfg.RateLimit.QueueTimeout,
+		}), scoreService)
+	}
+
+	unaryInterceptors := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor(), logging.UnaryServerInterceptor(), metrics.UnaryServerInterceptor()}
+	streamInterceptors := []grpc.StreamServerInterceptor{tracing.StreamServerInterceptor(), logging.StreamServerInterceptor(), metrics.StreamServerInterceptor()}
+	if cfg.Auth.Enabled {
+		authenticator, err := auth.NewAuthenticator(auth.Options{
+			HMACSecretFile: cfg.Auth.HMACSecretFile,
+			JWKSFile:       cfg.Auth.JWKSFile,
+			Issuer:         cfg.Auth.Issuer,
+			Audience:       cfg.Auth.Audience,
+			APIKeysFile:    cfg.Auth.APIKeysFile,
+			ExemptMethods:  cfg.Auth.ExemptMethodList(),
+		})
+		if err != nil {
+			return err
+		}
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryServerInterceptor(authenticator))
+		streamInterceptors = append(streamInterceptors, auth.StreamServerInterceptor(authenticator))
+	} else {
+		workspaceID := int64(cfg.Auth.DefaultWorkspaceID)
+		unaryInterceptors = append(unaryInterceptors, workspace.UnaryServerInterceptor(workspaceID))
+		streamInterceptors = append(streamInterceptors, workspace.StreamServerInterceptor(workspaceID))
+	}
+	interceptorOptions := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	serverOptions := append([]grpc.ServerOption{}, interceptorOptions...)
+	if cfg.TLS.CertFile != "" {
+		tlsConfig, err := server.NewTLSConfig(server.TLSFiles{CertFile: cfg.TLS.CertFile, KeyFile: cfg.TLS.KeyFile, ClientCAFile: cfg.TLS.ClientCAFile})
+		if err != nil {
+			return err
+		}
+		serverOptions = append(serverOptions, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
 
-	server := server.NewScoreServer(scoreService)
-	grpcServer := grpc.NewServer()
+	rangeLimits := util.RangeLimits{DefaultRange: cfg.Ranges.DefaultRange, MaxRange: cfg.Ranges.MaxRange}
+	scoreServer := server.NewScoreServer(scoreService, rangeLimits)
+	scoreServerV2 := server.NewScoreServerV2(scoreService, rangeLimits)
+	grpcServer := grpc.NewServer(serverOptions...)
 	reflection.Register(grpcServer)
-	pb.RegisterScoresServer(grpcServer, server)
-	log.Printf("server listening at %v", listener.Addr())
-	if err := grpcServer.Serve(listener); err != nil {
-		log.Fatalf("failed to serve: %v", err)
+	pb.RegisterScoresServer(grpcServer, scoreServer)
+	server.RegisterLegacyScoresServer(grpcServer, scoreServer)
+	pbv2.RegisterScoresServer(grpcServer, scoreServerV2)
+
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	healthChecker := health.NewChecker(db, healthServer, cfg.Health.CheckInterval,
+		pb.Scores_ServiceDesc.ServiceName, server.LegacyScoresServiceName, pbv2.Scores_ServiceDesc.ServiceName)
+	go healthChecker.Run(ctx)
+
+	var gatewayServer *http.Server
+	stopGateway := func() {}
+	if cfg.Listen.HTTPAddress != "" {
+		// The gateway calls an in-process copy of the server, with the same interceptors but no
+		// transport credentials, so REST, Connect and gRPC-Web calls are authenticated, scoped and
+		// limited like gRPC ones. Only that copy trusts the client address the gateway forwards.
+		inProcessServer := grpc.NewServer(append([]grpc.ServerOption{
+			grpc.ChainUnaryInterceptor(ratelimit.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(ratelimit.StreamServerInterceptor()),
+		}, interceptorOptions...)...)
+		pb.RegisterScoresServer(inProcessServer, scoreServer)
+		pbv2.RegisterScoresServer(inProcessServer, scoreServerV2)
+		conn, stop, err := gateway.DialInProcess(inProcessServer)
+		if err != nil {
+			return err
+		}
+		stopGateway = stop
+		handler, err := gateway.NewHandler(ctx, conn, gateway.Options{
+			AllowedOrigins: cfg.CORS.AllowedOriginList(),
+			CORSMaxAge:     cfg.CORS.MaxAge,
+			TrustedProxies: cfg.Listen.TrustedProxyList(),
+		})
+		if err != nil {
+			stop()
+			return err
+		}
+		gatewayServer = &http.Server{Addr: cfg.Listen.HTTPAddress, Handler: handler, ReadHeaderTimeout: 10 * time.Second}
+		if cfg.TLS.CertFile != "" {
+			gatewayServer.TLSConfig, err = server.NewTLSConfig(server.TLSFiles{
+				CertFile:     cfg.TLS.CertFile,
+				KeyFile:      cfg.TLS.KeyFile,
+				ClientCAFile: cfg.TLS.ClientCAFile,
+				NextProtos:   []string{"h2", "http/1.1"},
+			})
+			if err != nil {
+				stop()
+				return err
+			}
+		}
+	}
+
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	metricsServer := &http.Server{Addr: cfg.Listen.MetricsAddress, Handler: metricsMux, ReadHeaderTimeout: 10 * time.Second}
+
+	serveErr := make(chan error, 3)
+	go func() {
+		slog.Info("server listening", "address", listener.Addr().String(), "tls", cfg.TLS.CertFile != "", "mtls", cfg.TLS.ClientCAFile != "", "auth", cfg.Auth.Enabled)
+		serveErr <- grpcServer.Serve(listener)
+	}()
+	if gatewayServer != nil {
+		go func() {
+			slog.Info("gateway listening", "address", gatewayServer.Addr, "tls", gatewayServer.TLSConfig != nil)
+			var err error
+			if gatewayServer.TLSConfig != nil {
+				err = gatewayServer.ListenAndServeTLS("", "")
+			} else {
+				err = gatewayServer.ListenAndServe()
+			}
+			if !errors.Is(err, http.ErrServerClosed) {
+				serveErr <- err
+			}
+		}()
+	}
+	go func() {
+		slog.Info("metrics listening", "address", metricsServer.Addr)
+		if err := metricsServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			serveErr <- err
+		}
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("failed to serve: %w", err)
+	case <-ctx.Done():
 	}
 
+	slog.Info("shutting down, draining in-flight requests", "timeout", cfg.Listen.ShutdownTimeout)
+	gatewayStopped := make(chan struct{})
+	go func() {
+		defer close(gatewayStopped)
+		if gatewayServer == nil {
+			return
+		}
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Listen.ShutdownTimeout)
+		defer cancel()
+		if err := gatewayServer.Shutdown(shutdownCtx); err != nil {
+			slog.Warn("drain timeout exceeded for the gateway", "error", err)
+		}
+		stopGateway()
+	}()
+	if !server.GracefulStop(grpcServer, healthServer, cfg.Listen.ShutdownTimeout) {
+		slog.Warn("drain timeout exceeded, remaining requests were cancelled")
+	}
+	<-gatewayStopped
+	if err := metricsServer.Shutdown(context.Background()); err != nil {
+		slog.Error("got error when stopping the metrics server", "error", err)
+	}
+	slog.Info("server stopped")
+	return nil
 }
@@ -0,0 +1,48 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func observeRPC(method string, start time.Time, err error) {
+	code := status.Code(err).String()
+	rpcRequests.WithLabelValues(method, code).Inc()
+	rpcDuration.WithLabelValues(method, code).Observe(time.Since(start).Seconds())
+}
+
+// UnaryServerInterceptor records request counts and latencies of unary RPCs.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records request counts, latencies and sent messages of streaming RPCs.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, &countingServerStream{ServerStream: ss, method: info.FullMethod})
+		observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
+
+type countingServerStream struct {
+	grpc.ServerStream
+	method string
+}
+
+func (stream *countingServerStream) SendMsg(m interface{}) error {
+	err := stream.ServerStream.SendMsg(m)
+	if err == nil {
+		streamMessages.WithLabelValues(stream.method).Inc()
+	}
+	return err
+}
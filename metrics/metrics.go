@@ -0,0 +1,116 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "scores"
+
+// Registry holds every metric exposed by the service.
+var Registry = prometheus.NewRegistry()
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of handled RPCs by method and status code.",
+	}, []string{"method", "code"})
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of handled RPCs by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "code"})
+
+	streamMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "stream_messages_sent_total",
+		Help:      "Number of messages sent on server streams by method.",
+	}, []string{"method"})
+
+	queryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "repository",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of repository queries by method and result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "result"})
+
+	queryRows = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "repository",
+		Name:      "query_rows",
+		Help:      "Number of rows returned by repository queries by method.",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 8),
+	}, []string{"method"})
+
+	rateLimited = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ratelimit",
+		Name:      "rejected_total",
+		Help:      "Number of calls rejected by the rate limiter by method and reason.",
+	}, []string{"method", "reason"})
+
+	heavyQueries = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "ratelimit",
+		Name:      "heavy_queries",
+		Help:      "Number of heavy queries running or queued.",
+	}, []string{"state"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		rpcRequests,
+		rpcDuration,
+		streamMessages,
+		queryDuration,
+		queryRows,
+		rateLimited,
+		heavyQueries,
+	)
+}
+
+// RegisterDB exposes the connection pool statistics of db under the given name.
+func RegisterDB(db *sql.DB, name string) error {
+	return Registry.Register(collectors.NewDBStatsCollector(db, name))
+}
+
+// Handler serves the metrics in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
+
+// ObserveQuery records the duration and the number of returned rows of a repository query.
+func ObserveQuery(method string, duration time.Duration, rows int, err error) {
+	result := "success"
+	if err != nil {
+		result = "error"
+	}
+	queryDuration.WithLabelValues(method, result).Observe(duration.Seconds())
+	if err == nil {
+		queryRows.WithLabelValues(method).Observe(float64(rows))
+	}
+}
+
+// ObserveRateLimited counts a call rejected by the rate limiter.
+func ObserveRateLimited(method, reason string) {
+	rateLimited.WithLabelValues(method, reason).Inc()
+}
+
+// AddHeavyQueries adjusts the number of heavy queries in state, running or queued.
+func AddHeavyQueries(state string, delta float64) {
+	heavyQueries.WithLabelValues(state).Add(delta)
+}
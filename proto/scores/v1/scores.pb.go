@@ -0,0 +1,1027 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.0
+// 	protoc        v3.12.4
+// source: scores/v1/scores.proto
+
+package scoresv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ReportType int32
+
+const (
+	ReportType_REPORT_TYPE_UNSPECIFIED ReportType = 0
+	// A row per category and a column per daily or weekly bucket, N/A when it has no ratings.
+	ReportType_REPORT_TYPE_CATEGORIES_OVER_TIME ReportType = 1
+	// A row per ticket and a column per category, N/A when the ticket has no such rating.
+	ReportType_REPORT_TYPE_TICKETS ReportType = 2
+	ReportType_REPORT_TYPE_OVERALL ReportType = 3
+)
+
+// Enum value maps for ReportType.
+var (
+	ReportType_name = map[int32]string{
+		0: "REPORT_TYPE_UNSPECIFIED",
+		1: "REPORT_TYPE_CATEGORIES_OVER_TIME",
+		2: "REPORT_TYPE_TICKETS",
+		3: "REPORT_TYPE_OVERALL",
+	}
+	ReportType_value = map[string]int32{
+		"REPORT_TYPE_UNSPECIFIED":          0,
+		"REPORT_TYPE_CATEGORIES_OVER_TIME": 1,
+		"REPORT_TYPE_TICKETS":              2,
+		"REPORT_TYPE_OVERALL":              3,
+	}
+)
+
+func (x ReportType) Enum() *ReportType {
+	p := new(ReportType)
+	*p = x
+	return p
+}
+
+func (x ReportType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportType) Descriptor() protoreflect.EnumDescriptor {
+	return file_scores_v1_scores_proto_enumTypes[0].Descriptor()
+}
+
+func (ReportType) Type() protoreflect.EnumType {
+	return &file_scores_v1_scores_proto_enumTypes[0]
+}
+
+func (x ReportType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportType.Descriptor instead.
+func (ReportType) EnumDescriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{0}
+}
+
+type ExportFormat int32
+
+const (
+	// Read as CSV.
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_XLSX        ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_XLSX",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_XLSX":        2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_scores_v1_scores_proto_enumTypes[1].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_scores_v1_scores_proto_enumTypes[1]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{1}
+}
+
+// DateRangeRequest selects ratings created in the half-open range [from, to): a rating created
+// exactly at `from` is included, one created exactly at `to` is not. Timestamps are compared
+// with their full (sub-second) precision, so adjacent ranges sharing a boundary never
+// double-count a rating.
+type DateRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRangeRequest) Reset() {
+	*x = DateRangeRequest{}
+	mi := &file_scores_v1_scores_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRangeRequest) ProtoMessage() {}
+
+func (x *DateRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRangeRequest.ProtoReflect.Descriptor instead.
+func (*DateRangeRequest) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DateRangeRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DateRangeRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type RatingCategoryScore struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RatingCategoryID   int64                  `protobuf:"varint,1,opt,name=ratingCategoryID,proto3" json:"ratingCategoryID,omitempty"`
+	RatingCategoryName string                 `protobuf:"bytes,2,opt,name=ratingCategoryName,proto3" json:"ratingCategoryName,omitempty"`
+	Score              float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RatingCategoryScore) Reset() {
+	*x = RatingCategoryScore{}
+	mi := &file_scores_v1_scores_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingCategoryScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingCategoryScore) ProtoMessage() {}
+
+func (x *RatingCategoryScore) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingCategoryScore.ProtoReflect.Descriptor instead.
+func (*RatingCategoryScore) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RatingCategoryScore) GetRatingCategoryID() int64 {
+	if x != nil {
+		return x.RatingCategoryID
+	}
+	return 0
+}
+
+func (x *RatingCategoryScore) GetRatingCategoryName() string {
+	if x != nil {
+		return x.RatingCategoryName
+	}
+	return ""
+}
+
+func (x *RatingCategoryScore) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ScoreByTicket struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TicketId            int64                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	RatingCategoryScore []*RatingCategoryScore `protobuf:"bytes,2,rep,name=ratingCategoryScore,proto3" json:"ratingCategoryScore,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ScoreByTicket) Reset() {
+	*x = ScoreByTicket{}
+	mi := &file_scores_v1_scores_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreByTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreByTicket) ProtoMessage() {}
+
+func (x *ScoreByTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreByTicket.ProtoReflect.Descriptor instead.
+func (*ScoreByTicket) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScoreByTicket) GetTicketId() int64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *ScoreByTicket) GetRatingCategoryScore() []*RatingCategoryScore {
+	if x != nil {
+		return x.RatingCategoryScore
+	}
+	return nil
+}
+
+// PeriodScoreWithRatings covers the half-open aggregation bucket [from, to), either one UTC day
+// or one ISO week starting on Monday.
+type PeriodScoreWithRatings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Unset when the bucket has no ratings, or only ratings of categories weighing 0, which
+	// clients show as N/A; a set 0 is a genuine 0%.
+	Score         *float32 `protobuf:"fixed32,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	Ratings       int32    `protobuf:"varint,4,opt,name=ratings,proto3" json:"ratings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodScoreWithRatings) Reset() {
+	*x = PeriodScoreWithRatings{}
+	mi := &file_scores_v1_scores_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodScoreWithRatings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodScoreWithRatings) ProtoMessage() {}
+
+func (x *PeriodScoreWithRatings) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodScoreWithRatings.ProtoReflect.Descriptor instead.
+func (*PeriodScoreWithRatings) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PeriodScoreWithRatings) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PeriodScoreWithRatings) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PeriodScoreWithRatings) GetScore() float32 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *PeriodScoreWithRatings) GetRatings() int32 {
+	if x != nil {
+		return x.Ratings
+	}
+	return 0
+}
+
+// CategoryScoreOverTime is streamed once per rating category, in the configured order
+// (scores.category_order) with ties broken by categoryID.
+type CategoryScoreOverTime struct {
+	state                  protoimpl.MessageState    `protogen:"open.v1"`
+	CategoryName           string                    `protobuf:"bytes,1,opt,name=categoryName,proto3" json:"categoryName,omitempty"`
+	CategoryID             int64                     `protobuf:"varint,6,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	CategoryWeight         float32                   `protobuf:"fixed32,7,opt,name=categoryWeight,proto3" json:"categoryWeight,omitempty"`
+	PeriodScoreWithRatings []*PeriodScoreWithRatings `protobuf:"bytes,2,rep,name=periodScoreWithRatings,proto3" json:"periodScoreWithRatings,omitempty"`
+	// Weighted average of every rating of the range, like overAllScore, unset when no bucket has a
+	// score.
+	TotalScore  *float32 `protobuf:"fixed32,3,opt,name=totalScore,proto3,oneof" json:"totalScore,omitempty"`
+	TotalRating int32    `protobuf:"varint,4,opt,name=totalRating,proto3" json:"totalRating,omitempty"`
+	// Mean of the scores of the buckets that have one, unset when none has.
+	MeanPeriodScore *float32 `protobuf:"fixed32,5,opt,name=meanPeriodScore,proto3,oneof" json:"meanPeriodScore,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CategoryScoreOverTime) Reset() {
+	*x = CategoryScoreOverTime{}
+	mi := &file_scores_v1_scores_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryScoreOverTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryScoreOverTime) ProtoMessage() {}
+
+func (x *CategoryScoreOverTime) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryScoreOverTime.ProtoReflect.Descriptor instead.
+func (*CategoryScoreOverTime) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CategoryScoreOverTime) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryScoreOverTime) GetCategoryID() int64 {
+	if x != nil {
+		return x.CategoryID
+	}
+	return 0
+}
+
+func (x *CategoryScoreOverTime) GetCategoryWeight() float32 {
+	if x != nil {
+		return x.CategoryWeight
+	}
+	return 0
+}
+
+func (x *CategoryScoreOverTime) GetPeriodScoreWithRatings() []*PeriodScoreWithRatings {
+	if x != nil {
+		return x.PeriodScoreWithRatings
+	}
+	return nil
+}
+
+func (x *CategoryScoreOverTime) GetTotalScore() float32 {
+	if x != nil && x.TotalScore != nil {
+		return *x.TotalScore
+	}
+	return 0
+}
+
+func (x *CategoryScoreOverTime) GetTotalRating() int32 {
+	if x != nil {
+		return x.TotalRating
+	}
+	return 0
+}
+
+func (x *CategoryScoreOverTime) GetMeanPeriodScore() float32 {
+	if x != nil && x.MeanPeriodScore != nil {
+		return *x.MeanPeriodScore
+	}
+	return 0
+}
+
+type OverAllQualityScoreResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset when the range has no ratings.
+	OverAllScore  *float32 `protobuf:"fixed32,1,opt,name=overAllScore,proto3,oneof" json:"overAllScore,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverAllQualityScoreResponse) Reset() {
+	*x = OverAllQualityScoreResponse{}
+	mi := &file_scores_v1_scores_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverAllQualityScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverAllQualityScoreResponse) ProtoMessage() {}
+
+func (x *OverAllQualityScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverAllQualityScoreResponse.ProtoReflect.Descriptor instead.
+func (*OverAllQualityScoreResponse) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OverAllQualityScoreResponse) GetOverAllScore() float32 {
+	if x != nil && x.OverAllScore != nil {
+		return *x.OverAllScore
+	}
+	return 0
+}
+
+// PeriodScore covers the half-open range [from, to).
+type PeriodScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Score         float32                `protobuf:"fixed32,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodScore) Reset() {
+	*x = PeriodScore{}
+	mi := &file_scores_v1_scores_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodScore) ProtoMessage() {}
+
+func (x *PeriodScore) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodScore.ProtoReflect.Descriptor instead.
+func (*PeriodScore) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PeriodScore) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PeriodScore) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PeriodScore) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type GetPeriodOverPeriodScoreChangeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPeriod   *PeriodScore           `protobuf:"bytes,1,opt,name=CurrentPeriod,proto3" json:"CurrentPeriod,omitempty"`
+	PreviousPeriod  *PeriodScore           `protobuf:"bytes,2,opt,name=PreviousPeriod,proto3" json:"PreviousPeriod,omitempty"`
+	ScoreDifference float32                `protobuf:"fixed32,3,opt,name=ScoreDifference,proto3" json:"ScoreDifference,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) Reset() {
+	*x = GetPeriodOverPeriodScoreChangeResponse{}
+	mi := &file_scores_v1_scores_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeriodOverPeriodScoreChangeResponse) ProtoMessage() {}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeriodOverPeriodScoreChangeResponse.ProtoReflect.Descriptor instead.
+func (*GetPeriodOverPeriodScoreChangeResponse) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) GetCurrentPeriod() *PeriodScore {
+	if x != nil {
+		return x.CurrentPeriod
+	}
+	return nil
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) GetPreviousPeriod() *PeriodScore {
+	if x != nil {
+		return x.PreviousPeriod
+	}
+	return nil
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) GetScoreDifference() float32 {
+	if x != nil {
+		return x.ScoreDifference
+	}
+	return 0
+}
+
+// ExportReportRequest covers the same half-open range [from, to) as DateRangeRequest.
+type ExportReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Report        ReportType             `protobuf:"varint,3,opt,name=report,proto3,enum=scores.v1.ReportType" json:"report,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,4,opt,name=format,proto3,enum=scores.v1.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReportRequest) Reset() {
+	*x = ExportReportRequest{}
+	mi := &file_scores_v1_scores_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReportRequest) ProtoMessage() {}
+
+func (x *ExportReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportReportRequest) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ExportReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ExportReportRequest) GetReport() ReportType {
+	if x != nil {
+		return x.Report
+	}
+	return ReportType_REPORT_TYPE_UNSPECIFIED
+}
+
+func (x *ExportReportRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+// ExportChunk is a part of the exported file; concatenating the data of every chunk gives the
+// whole file. The file name and content type are only set on the first chunk.
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_scores_v1_scores_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v1_scores_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_scores_v1_scores_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+var File_scores_v1_scores_proto protoreflect.FileDescriptor
+
+var file_scores_v1_scores_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x10, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02,
+	0x74, 0x6f, 0x22, 0x87, 0x01, 0x0a, 0x13, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x7e, 0x0a, 0x0d,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x50, 0x0a, 0x13, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x13, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xb3, 0x01, 0x0a,
+	0x16, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x02, 0x74, 0x6f, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x22, 0xf7, 0x02, 0x0a, 0x15, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x44,
+	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x59, 0x0a, 0x16, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x16, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2d, 0x0a, 0x0f, 0x6d, 0x65,
+	0x61, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6d, 0x65, 0x61,
+	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x57, 0x0a, 0x1b,
+	0x4f, 0x76, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0c, 0x6f,
+	0x76, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x6c, 0x6c,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x7f, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x52, 0x0d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x3e, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52,
+	0x0e, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x28, 0x0a, 0x0f, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x44,
+	0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xd1, 0x01, 0x0a, 0x13, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x2d, 0x0a,
+	0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x5f, 0x0a,
+	0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x2a, 0x81,
+	0x01, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x52, 0x45,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f,
+	0x52, 0x49, 0x45, 0x53, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x4c, 0x4c,
+	0x10, 0x03, 0x2a, 0x5c, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x58, 0x4c, 0x53, 0x58, 0x10, 0x02,
+	0x32, 0xfd, 0x04, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x1b, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x79,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x30, 0x01, 0x12, 0x8f, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x2d,
+	0x74, 0x69, 0x6d, 0x65, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65,
+	0x72, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1b, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x12, 0x97, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f,
+	0x76, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2d,
+	0x6f, 0x76, 0x65, 0x72, 0x2d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x63, 0x0a, 0x0c, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01,
+	0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x66,
+	0x65, 0x72, 0x6e, 0x61, 0x6e, 0x64, 0x6f, 0x61, 0x6c, 0x61, 0x76, 0x61, 0x2f, 0x73, 0x6f, 0x66,
+	0x74, 0x77, 0x61, 0x72, 0x65, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x65, 0x72, 0x2d, 0x74, 0x65,
+	0x73, 0x74, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_scores_v1_scores_proto_rawDescOnce sync.Once
+	file_scores_v1_scores_proto_rawDescData = file_scores_v1_scores_proto_rawDesc
+)
+
+func file_scores_v1_scores_proto_rawDescGZIP() []byte {
+	file_scores_v1_scores_proto_rawDescOnce.Do(func() {
+		file_scores_v1_scores_proto_rawDescData = protoimpl.X.CompressGZIP(file_scores_v1_scores_proto_rawDescData)
+	})
+	return file_scores_v1_scores_proto_rawDescData
+}
+
+var file_scores_v1_scores_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_scores_v1_scores_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_scores_v1_scores_proto_goTypes = []any{
+	(ReportType)(0),                                // 0: scores.v1.ReportType
+	(ExportFormat)(0),                              // 1: scores.v1.ExportFormat
+	(*DateRangeRequest)(nil),                       // 2: scores.v1.DateRangeRequest
+	(*RatingCategoryScore)(nil),                    // 3: scores.v1.RatingCategoryScore
+	(*ScoreByTicket)(nil),                          // 4: scores.v1.ScoreByTicket
+	(*PeriodScoreWithRatings)(nil),                 // 5: scores.v1.PeriodScoreWithRatings
+	(*CategoryScoreOverTime)(nil),                  // 6: scores.v1.CategoryScoreOverTime
+	(*OverAllQualityScoreResponse)(nil),            // 7: scores.v1.OverAllQualityScoreResponse
+	(*PeriodScore)(nil),                            // 8: scores.v1.PeriodScore
+	(*GetPeriodOverPeriodScoreChangeResponse)(nil), // 9: scores.v1.GetPeriodOverPeriodScoreChangeResponse
+	(*ExportReportRequest)(nil),                    // 10: scores.v1.ExportReportRequest
+	(*ExportChunk)(nil),                            // 11: scores.v1.ExportChunk
+	(*timestamppb.Timestamp)(nil),                  // 12: google.protobuf.Timestamp
+}
+var file_scores_v1_scores_proto_depIdxs = []int32{
+	12, // 0: scores.v1.DateRangeRequest.from:type_name -> google.protobuf.Timestamp
+	12, // 1: scores.v1.DateRangeRequest.to:type_name -> google.protobuf.Timestamp
+	3,  // 2: scores.v1.ScoreByTicket.ratingCategoryScore:type_name -> scores.v1.RatingCategoryScore
+	12, // 3: scores.v1.PeriodScoreWithRatings.from:type_name -> google.protobuf.Timestamp
+	12, // 4: scores.v1.PeriodScoreWithRatings.to:type_name -> google.protobuf.Timestamp
+	5,  // 5: scores.v1.CategoryScoreOverTime.periodScoreWithRatings:type_name -> scores.v1.PeriodScoreWithRatings
+	12, // 6: scores.v1.PeriodScore.from:type_name -> google.protobuf.Timestamp
+	12, // 7: scores.v1.PeriodScore.to:type_name -> google.protobuf.Timestamp
+	8,  // 8: scores.v1.GetPeriodOverPeriodScoreChangeResponse.CurrentPeriod:type_name -> scores.v1.PeriodScore
+	8,  // 9: scores.v1.GetPeriodOverPeriodScoreChangeResponse.PreviousPeriod:type_name -> scores.v1.PeriodScore
+	12, // 10: scores.v1.ExportReportRequest.from:type_name -> google.protobuf.Timestamp
+	12, // 11: scores.v1.ExportReportRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 12: scores.v1.ExportReportRequest.report:type_name -> scores.v1.ReportType
+	1,  // 13: scores.v1.ExportReportRequest.format:type_name -> scores.v1.ExportFormat
+	2,  // 14: scores.v1.Scores.GetScoreByTicket:input_type -> scores.v1.DateRangeRequest
+	2,  // 15: scores.v1.Scores.GetAggregatedCategoryScoresOverTime:input_type -> scores.v1.DateRangeRequest
+	2,  // 16: scores.v1.Scores.GetOverAllQualityScore:input_type -> scores.v1.DateRangeRequest
+	2,  // 17: scores.v1.Scores.GetPeriodOverPeriodScoreChange:input_type -> scores.v1.DateRangeRequest
+	10, // 18: scores.v1.Scores.ExportReport:input_type -> scores.v1.ExportReportRequest
+	4,  // 19: scores.v1.Scores.GetScoreByTicket:output_type -> scores.v1.ScoreByTicket
+	6,  // 20: scores.v1.Scores.GetAggregatedCategoryScoresOverTime:output_type -> scores.v1.CategoryScoreOverTime
+	7,  // 21: scores.v1.Scores.GetOverAllQualityScore:output_type -> scores.v1.OverAllQualityScoreResponse
+	9,  // 22: scores.v1.Scores.GetPeriodOverPeriodScoreChange:output_type -> scores.v1.GetPeriodOverPeriodScoreChangeResponse
+	11, // 23: scores.v1.Scores.ExportReport:output_type -> scores.v1.ExportChunk
+	19, // [19:24] is the sub-list for method output_type
+	14, // [14:19] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_scores_v1_scores_proto_init() }
+func file_scores_v1_scores_proto_init() {
+	if File_scores_v1_scores_proto != nil {
+		return
+	}
+	file_scores_v1_scores_proto_msgTypes[3].OneofWrappers = []any{}
+	file_scores_v1_scores_proto_msgTypes[4].OneofWrappers = []any{}
+	file_scores_v1_scores_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_scores_v1_scores_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_scores_v1_scores_proto_goTypes,
+		DependencyIndexes: file_scores_v1_scores_proto_depIdxs,
+		EnumInfos:         file_scores_v1_scores_proto_enumTypes,
+		MessageInfos:      file_scores_v1_scores_proto_msgTypes,
+	}.Build()
+	File_scores_v1_scores_proto = out.File
+	file_scores_v1_scores_proto_rawDesc = nil
+	file_scores_v1_scores_proto_goTypes = nil
+	file_scores_v1_scores_proto_depIdxs = nil
+}
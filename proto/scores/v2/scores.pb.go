@@ -0,0 +1,1083 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.0
+// 	protoc        v3.12.4
+// source: scores/v2/scores.proto
+
+package scoresv2
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DashboardSection int32
+
+const (
+	DashboardSection_DASHBOARD_SECTION_UNSPECIFIED DashboardSection = 0
+	// The overall quality score, as returned by GetOverAllQualityScore.
+	DashboardSection_DASHBOARD_SECTION_OVERALL DashboardSection = 1
+	// The change from the previous period, as returned by GetPeriodOverPeriodScoreChange.
+	DashboardSection_DASHBOARD_SECTION_CHANGE DashboardSection = 2
+	// The scores of every category, as streamed by GetAggregatedCategoryScoresOverTime.
+	DashboardSection_DASHBOARD_SECTION_CATEGORIES_OVER_TIME DashboardSection = 3
+	// The lowest scoring tickets.
+	DashboardSection_DASHBOARD_SECTION_WORST_TICKETS DashboardSection = 4
+)
+
+// Enum value maps for DashboardSection.
+var (
+	DashboardSection_name = map[int32]string{
+		0: "DASHBOARD_SECTION_UNSPECIFIED",
+		1: "DASHBOARD_SECTION_OVERALL",
+		2: "DASHBOARD_SECTION_CHANGE",
+		3: "DASHBOARD_SECTION_CATEGORIES_OVER_TIME",
+		4: "DASHBOARD_SECTION_WORST_TICKETS",
+	}
+	DashboardSection_value = map[string]int32{
+		"DASHBOARD_SECTION_UNSPECIFIED":          0,
+		"DASHBOARD_SECTION_OVERALL":              1,
+		"DASHBOARD_SECTION_CHANGE":               2,
+		"DASHBOARD_SECTION_CATEGORIES_OVER_TIME": 3,
+		"DASHBOARD_SECTION_WORST_TICKETS":        4,
+	}
+)
+
+func (x DashboardSection) Enum() *DashboardSection {
+	p := new(DashboardSection)
+	*p = x
+	return p
+}
+
+func (x DashboardSection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DashboardSection) Descriptor() protoreflect.EnumDescriptor {
+	return file_scores_v2_scores_proto_enumTypes[0].Descriptor()
+}
+
+func (DashboardSection) Type() protoreflect.EnumType {
+	return &file_scores_v2_scores_proto_enumTypes[0]
+}
+
+func (x DashboardSection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DashboardSection.Descriptor instead.
+func (DashboardSection) EnumDescriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{0}
+}
+
+// DateRangeRequest selects ratings created in the half-open range [from, to), compared with
+// their full (sub-second) precision.
+type DateRangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRangeRequest) Reset() {
+	*x = DateRangeRequest{}
+	mi := &file_scores_v2_scores_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRangeRequest) ProtoMessage() {}
+
+func (x *DateRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRangeRequest.ProtoReflect.Descriptor instead.
+func (*DateRangeRequest) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DateRangeRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DateRangeRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type RatingCategoryScore struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RatingCategoryId   int64                  `protobuf:"varint,1,opt,name=rating_category_id,json=ratingCategoryId,proto3" json:"rating_category_id,omitempty"`
+	RatingCategoryName string                 `protobuf:"bytes,2,opt,name=rating_category_name,json=ratingCategoryName,proto3" json:"rating_category_name,omitempty"`
+	Score              float64                `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RatingCategoryScore) Reset() {
+	*x = RatingCategoryScore{}
+	mi := &file_scores_v2_scores_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RatingCategoryScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingCategoryScore) ProtoMessage() {}
+
+func (x *RatingCategoryScore) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingCategoryScore.ProtoReflect.Descriptor instead.
+func (*RatingCategoryScore) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RatingCategoryScore) GetRatingCategoryId() int64 {
+	if x != nil {
+		return x.RatingCategoryId
+	}
+	return 0
+}
+
+func (x *RatingCategoryScore) GetRatingCategoryName() string {
+	if x != nil {
+		return x.RatingCategoryName
+	}
+	return ""
+}
+
+func (x *RatingCategoryScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type ScoreByTicket struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TicketId             int64                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	RatingCategoryScores []*RatingCategoryScore `protobuf:"bytes,2,rep,name=rating_category_scores,json=ratingCategoryScores,proto3" json:"rating_category_scores,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ScoreByTicket) Reset() {
+	*x = ScoreByTicket{}
+	mi := &file_scores_v2_scores_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreByTicket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreByTicket) ProtoMessage() {}
+
+func (x *ScoreByTicket) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreByTicket.ProtoReflect.Descriptor instead.
+func (*ScoreByTicket) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ScoreByTicket) GetTicketId() int64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *ScoreByTicket) GetRatingCategoryScores() []*RatingCategoryScore {
+	if x != nil {
+		return x.RatingCategoryScores
+	}
+	return nil
+}
+
+// PeriodScoreWithRatings covers the half-open aggregation bucket [from, to), either one UTC day
+// or one ISO week starting on Monday.
+type PeriodScoreWithRatings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Unset when the bucket has no ratings, or only ratings of categories weighing 0, which
+	// clients show as N/A; a set 0 is a genuine 0%.
+	Score         *float64 `protobuf:"fixed64,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	Ratings       int32    `protobuf:"varint,4,opt,name=ratings,proto3" json:"ratings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodScoreWithRatings) Reset() {
+	*x = PeriodScoreWithRatings{}
+	mi := &file_scores_v2_scores_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodScoreWithRatings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodScoreWithRatings) ProtoMessage() {}
+
+func (x *PeriodScoreWithRatings) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodScoreWithRatings.ProtoReflect.Descriptor instead.
+func (*PeriodScoreWithRatings) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PeriodScoreWithRatings) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PeriodScoreWithRatings) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PeriodScoreWithRatings) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+func (x *PeriodScoreWithRatings) GetRatings() int32 {
+	if x != nil {
+		return x.Ratings
+	}
+	return 0
+}
+
+// CategoryScoreOverTime is streamed once per rating category, in the configured order
+// (scores.category_order) with ties broken by category_id.
+type CategoryScoreOverTime struct {
+	state                   protoimpl.MessageState    `protogen:"open.v1"`
+	CategoryName            string                    `protobuf:"bytes,1,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	CategoryId              int64                     `protobuf:"varint,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryWeight          float32                   `protobuf:"fixed32,7,opt,name=category_weight,json=categoryWeight,proto3" json:"category_weight,omitempty"`
+	PeriodScoresWithRatings []*PeriodScoreWithRatings `protobuf:"bytes,2,rep,name=period_scores_with_ratings,json=periodScoresWithRatings,proto3" json:"period_scores_with_ratings,omitempty"`
+	// Weighted average of every rating of the range, like overall_score, unset when no bucket has
+	// a score.
+	TotalScore  *float64 `protobuf:"fixed64,3,opt,name=total_score,json=totalScore,proto3,oneof" json:"total_score,omitempty"`
+	TotalRating int32    `protobuf:"varint,4,opt,name=total_rating,json=totalRating,proto3" json:"total_rating,omitempty"`
+	// Mean of the scores of the buckets that have one, unset when none has.
+	MeanPeriodScore *float64 `protobuf:"fixed64,5,opt,name=mean_period_score,json=meanPeriodScore,proto3,oneof" json:"mean_period_score,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CategoryScoreOverTime) Reset() {
+	*x = CategoryScoreOverTime{}
+	mi := &file_scores_v2_scores_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryScoreOverTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryScoreOverTime) ProtoMessage() {}
+
+func (x *CategoryScoreOverTime) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryScoreOverTime.ProtoReflect.Descriptor instead.
+func (*CategoryScoreOverTime) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CategoryScoreOverTime) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CategoryScoreOverTime) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryScoreOverTime) GetCategoryWeight() float32 {
+	if x != nil {
+		return x.CategoryWeight
+	}
+	return 0
+}
+
+func (x *CategoryScoreOverTime) GetPeriodScoresWithRatings() []*PeriodScoreWithRatings {
+	if x != nil {
+		return x.PeriodScoresWithRatings
+	}
+	return nil
+}
+
+func (x *CategoryScoreOverTime) GetTotalScore() float64 {
+	if x != nil && x.TotalScore != nil {
+		return *x.TotalScore
+	}
+	return 0
+}
+
+func (x *CategoryScoreOverTime) GetTotalRating() int32 {
+	if x != nil {
+		return x.TotalRating
+	}
+	return 0
+}
+
+func (x *CategoryScoreOverTime) GetMeanPeriodScore() float64 {
+	if x != nil && x.MeanPeriodScore != nil {
+		return *x.MeanPeriodScore
+	}
+	return 0
+}
+
+type OverAllQualityScoreResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unset when the range has no ratings.
+	OverallScore  *float64 `protobuf:"fixed64,1,opt,name=overall_score,json=overallScore,proto3,oneof" json:"overall_score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OverAllQualityScoreResponse) Reset() {
+	*x = OverAllQualityScoreResponse{}
+	mi := &file_scores_v2_scores_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OverAllQualityScoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OverAllQualityScoreResponse) ProtoMessage() {}
+
+func (x *OverAllQualityScoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OverAllQualityScoreResponse.ProtoReflect.Descriptor instead.
+func (*OverAllQualityScoreResponse) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OverAllQualityScoreResponse) GetOverallScore() float64 {
+	if x != nil && x.OverallScore != nil {
+		return *x.OverallScore
+	}
+	return 0
+}
+
+// PeriodScore covers the half-open range [from, to).
+type PeriodScore struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Unset when the period has no ratings.
+	Score         *float64 `protobuf:"fixed64,3,opt,name=score,proto3,oneof" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PeriodScore) Reset() {
+	*x = PeriodScore{}
+	mi := &file_scores_v2_scores_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PeriodScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeriodScore) ProtoMessage() {}
+
+func (x *PeriodScore) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeriodScore.ProtoReflect.Descriptor instead.
+func (*PeriodScore) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PeriodScore) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *PeriodScore) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *PeriodScore) GetScore() float64 {
+	if x != nil && x.Score != nil {
+		return *x.Score
+	}
+	return 0
+}
+
+type GetPeriodOverPeriodScoreChangeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPeriod  *PeriodScore           `protobuf:"bytes,1,opt,name=current_period,json=currentPeriod,proto3" json:"current_period,omitempty"`
+	PreviousPeriod *PeriodScore           `protobuf:"bytes,2,opt,name=previous_period,json=previousPeriod,proto3" json:"previous_period,omitempty"`
+	// Relative change where 1 is +100%, unset when either period has no ratings or the previous
+	// one scored 0.
+	ScoreDifference *float64 `protobuf:"fixed64,3,opt,name=score_difference,json=scoreDifference,proto3,oneof" json:"score_difference,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) Reset() {
+	*x = GetPeriodOverPeriodScoreChangeResponse{}
+	mi := &file_scores_v2_scores_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPeriodOverPeriodScoreChangeResponse) ProtoMessage() {}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPeriodOverPeriodScoreChangeResponse.ProtoReflect.Descriptor instead.
+func (*GetPeriodOverPeriodScoreChangeResponse) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) GetCurrentPeriod() *PeriodScore {
+	if x != nil {
+		return x.CurrentPeriod
+	}
+	return nil
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) GetPreviousPeriod() *PeriodScore {
+	if x != nil {
+		return x.PreviousPeriod
+	}
+	return nil
+}
+
+func (x *GetPeriodOverPeriodScoreChangeResponse) GetScoreDifference() float64 {
+	if x != nil && x.ScoreDifference != nil {
+		return *x.ScoreDifference
+	}
+	return 0
+}
+
+// DashboardRequest selects the sections to compute over the half-open range [from, to), at
+// least one.
+type DashboardRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	From     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Sections []DashboardSection     `protobuf:"varint,3,rep,packed,name=sections,proto3,enum=scores.v2.DashboardSection" json:"sections,omitempty"`
+	// How many tickets DASHBOARD_SECTION_WORST_TICKETS returns, 10 when 0 and at most 100.
+	WorstTicketsLimit int32 `protobuf:"varint,4,opt,name=worst_tickets_limit,json=worstTicketsLimit,proto3" json:"worst_tickets_limit,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DashboardRequest) Reset() {
+	*x = DashboardRequest{}
+	mi := &file_scores_v2_scores_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DashboardRequest) ProtoMessage() {}
+
+func (x *DashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DashboardRequest.ProtoReflect.Descriptor instead.
+func (*DashboardRequest) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DashboardRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DashboardRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *DashboardRequest) GetSections() []DashboardSection {
+	if x != nil {
+		return x.Sections
+	}
+	return nil
+}
+
+func (x *DashboardRequest) GetWorstTicketsLimit() int32 {
+	if x != nil {
+		return x.WorstTicketsLimit
+	}
+	return 0
+}
+
+// TicketScore is the score of a ticket over all of its ratings of the range, weighted by their
+// category.
+type TicketScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TicketId      int64                  `protobuf:"varint,1,opt,name=ticket_id,json=ticketId,proto3" json:"ticket_id,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Ratings       int32                  `protobuf:"varint,3,opt,name=ratings,proto3" json:"ratings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TicketScore) Reset() {
+	*x = TicketScore{}
+	mi := &file_scores_v2_scores_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TicketScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TicketScore) ProtoMessage() {}
+
+func (x *TicketScore) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TicketScore.ProtoReflect.Descriptor instead.
+func (*TicketScore) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *TicketScore) GetTicketId() int64 {
+	if x != nil {
+		return x.TicketId
+	}
+	return 0
+}
+
+func (x *TicketScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *TicketScore) GetRatings() int32 {
+	if x != nil {
+		return x.Ratings
+	}
+	return 0
+}
+
+// DashboardResponse only sets the requested sections.
+type DashboardResponse struct {
+	state              protoimpl.MessageState                  `protogen:"open.v1"`
+	Overall            *OverAllQualityScoreResponse            `protobuf:"bytes,1,opt,name=overall,proto3" json:"overall,omitempty"`
+	Change             *GetPeriodOverPeriodScoreChangeResponse `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	CategoriesOverTime []*CategoryScoreOverTime                `protobuf:"bytes,3,rep,name=categories_over_time,json=categoriesOverTime,proto3" json:"categories_over_time,omitempty"`
+	// Lowest score first, ties broken by ticket_id. Tickets whose ratings all weigh 0 have no
+	// score and are left out.
+	WorstTickets  []*TicketScore `protobuf:"bytes,4,rep,name=worst_tickets,json=worstTickets,proto3" json:"worst_tickets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DashboardResponse) Reset() {
+	*x = DashboardResponse{}
+	mi := &file_scores_v2_scores_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DashboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DashboardResponse) ProtoMessage() {}
+
+func (x *DashboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_v2_scores_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DashboardResponse.ProtoReflect.Descriptor instead.
+func (*DashboardResponse) Descriptor() ([]byte, []int) {
+	return file_scores_v2_scores_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DashboardResponse) GetOverall() *OverAllQualityScoreResponse {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *DashboardResponse) GetChange() *GetPeriodOverPeriodScoreChangeResponse {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+func (x *DashboardResponse) GetCategoriesOverTime() []*CategoryScoreOverTime {
+	if x != nil {
+		return x.CategoriesOverTime
+	}
+	return nil
+}
+
+func (x *DashboardResponse) GetWorstTickets() []*TicketScore {
+	if x != nil {
+		return x.WorstTickets
+	}
+	return nil
+}
+
+var File_scores_v2_scores_proto protoreflect.FileDescriptor
+
+var file_scores_v2_scores_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x10, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02,
+	0x74, 0x6f, 0x22, 0x8b, 0x01, 0x0a, 0x13, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0x82, 0x01, 0x0a, 0x0d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b,
+	0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12,
+	0x54, 0x0a, 0x16, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52,
+	0x14, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x22, 0xb3, 0x01, 0x0a, 0x16, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x19, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x86, 0x03, 0x0a, 0x15,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x4f, 0x76, 0x65,
+	0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x5e, 0x0a, 0x1a, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x17, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x2f, 0x0a,
+	0x11, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x6e,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x22, 0x59, 0x0a, 0x1b, 0x4f, 0x76, 0x65, 0x72, 0x41, 0x6c, 0x6c, 0x51,
+	0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22,
+	0x8e, 0x01, 0x0a, 0x0b, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x19, 0x0a, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x22, 0xed, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x76,
+	0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0d, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3f, 0x0a, 0x0f, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0e, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x10, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x44, 0x69,
+	0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x22, 0xd7, 0x01, 0x0a, 0x10, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x2a, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x37, 0x0a, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e,
+	0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x08, 0x73, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x6f,
+	0x72, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5a, 0x0a, 0x0b, 0x54, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x63,
+	0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x69,
+	0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x11, 0x44, 0x61, 0x73, 0x68, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x07,
+	0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x49,
+	0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31,
+	0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x52, 0x0a, 0x14, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x2e, 0x76, 0x32, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a,
+	0x0d, 0x77, 0x6f, 0x72, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x0c, 0x77, 0x6f,
+	0x72, 0x73, 0x74, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2a, 0xc3, 0x01, 0x0a, 0x10, 0x44,
+	0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x0a, 0x1d, 0x44, 0x41, 0x53, 0x48, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x53, 0x48, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f,
+	0x53, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x41, 0x4c, 0x4c, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x41, 0x53, 0x48, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x53,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x02, 0x12,
+	0x2a, 0x0a, 0x26, 0x44, 0x41, 0x53, 0x48, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x49, 0x45, 0x53, 0x5f,
+	0x4f, 0x56, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x44,
+	0x41, 0x53, 0x48, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x57, 0x4f, 0x52, 0x53, 0x54, 0x5f, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x04,
+	0x32, 0x81, 0x05, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x79, 0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x12,
+	0x1b, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x65,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x42, 0x79,
+	0x54, 0x69, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
+	0x2f, 0x76, 0x32, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x74, 0x69, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x30, 0x01, 0x12, 0x8f, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x2e, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x53, 0x63,
+	0x6f, 0x72, 0x65, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x2d,
+	0x74, 0x69, 0x6d, 0x65, 0x30, 0x01, 0x12, 0x79, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x76, 0x65,
+	0x72, 0x41, 0x6c, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65,
+	0x12, 0x1b, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x74,
+	0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x41, 0x6c,
+	0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x76, 0x32, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c,
+	0x6c, 0x12, 0x97, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f,
+	0x76, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32,
+	0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x31, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4f, 0x76, 0x65, 0x72, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76,
+	0x32, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x2d,
+	0x6f, 0x76, 0x65, 0x72, 0x2d, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x67, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x2e, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x61, 0x73, 0x68, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14,
+	0x2f, 0x76, 0x32, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x64, 0x61, 0x73, 0x68, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x42, 0x4e, 0x5a, 0x4c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x66, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x64, 0x6f, 0x61, 0x6c, 0x61, 0x76, 0x61,
+	0x2f, 0x73, 0x6f, 0x66, 0x74, 0x77, 0x61, 0x72, 0x65, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x65,
+	0x72, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2f, 0x76, 0x32, 0x3b, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x76, 0x32, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_scores_v2_scores_proto_rawDescOnce sync.Once
+	file_scores_v2_scores_proto_rawDescData = file_scores_v2_scores_proto_rawDesc
+)
+
+func file_scores_v2_scores_proto_rawDescGZIP() []byte {
+	file_scores_v2_scores_proto_rawDescOnce.Do(func() {
+		file_scores_v2_scores_proto_rawDescData = protoimpl.X.CompressGZIP(file_scores_v2_scores_proto_rawDescData)
+	})
+	return file_scores_v2_scores_proto_rawDescData
+}
+
+var file_scores_v2_scores_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_scores_v2_scores_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_scores_v2_scores_proto_goTypes = []any{
+	(DashboardSection)(0),                          // 0: scores.v2.DashboardSection
+	(*DateRangeRequest)(nil),                       // 1: scores.v2.DateRangeRequest
+	(*RatingCategoryScore)(nil),                    // 2: scores.v2.RatingCategoryScore
+	(*ScoreByTicket)(nil),                          // 3: scores.v2.ScoreByTicket
+	(*PeriodScoreWithRatings)(nil),                 // 4: scores.v2.PeriodScoreWithRatings
+	(*CategoryScoreOverTime)(nil),                  // 5: scores.v2.CategoryScoreOverTime
+	(*OverAllQualityScoreResponse)(nil),            // 6: scores.v2.OverAllQualityScoreResponse
+	(*PeriodScore)(nil),                            // 7: scores.v2.PeriodScore
+	(*GetPeriodOverPeriodScoreChangeResponse)(nil), // 8: scores.v2.GetPeriodOverPeriodScoreChangeResponse
+	(*DashboardRequest)(nil),                       // 9: scores.v2.DashboardRequest
+	(*TicketScore)(nil),                            // 10: scores.v2.TicketScore
+	(*DashboardResponse)(nil),                      // 11: scores.v2.DashboardResponse
+	(*timestamppb.Timestamp)(nil),                  // 12: google.protobuf.Timestamp
+}
+var file_scores_v2_scores_proto_depIdxs = []int32{
+	12, // 0: scores.v2.DateRangeRequest.from:type_name -> google.protobuf.Timestamp
+	12, // 1: scores.v2.DateRangeRequest.to:type_name -> google.protobuf.Timestamp
+	2,  // 2: scores.v2.ScoreByTicket.rating_category_scores:type_name -> scores.v2.RatingCategoryScore
+	12, // 3: scores.v2.PeriodScoreWithRatings.from:type_name -> google.protobuf.Timestamp
+	12, // 4: scores.v2.PeriodScoreWithRatings.to:type_name -> google.protobuf.Timestamp
+	4,  // 5: scores.v2.CategoryScoreOverTime.period_scores_with_ratings:type_name -> scores.v2.PeriodScoreWithRatings
+	12, // 6: scores.v2.PeriodScore.from:type_name -> google.protobuf.Timestamp
+	12, // 7: scores.v2.PeriodScore.to:type_name -> google.protobuf.Timestamp
+	7,  // 8: scores.v2.GetPeriodOverPeriodScoreChangeResponse.current_period:type_name -> scores.v2.PeriodScore
+	7,  // 9: scores.v2.GetPeriodOverPeriodScoreChangeResponse.previous_period:type_name -> scores.v2.PeriodScore
+	12, // 10: scores.v2.DashboardRequest.from:type_name -> google.protobuf.Timestamp
+	12, // 11: scores.v2.DashboardRequest.to:type_name -> google.protobuf.Timestamp
+	0,  // 12: scores.v2.DashboardRequest.sections:type_name -> scores.v2.DashboardSection
+	6,  // 13: scores.v2.DashboardResponse.overall:type_name -> scores.v2.OverAllQualityScoreResponse
+	8,  // 14: scores.v2.DashboardResponse.change:type_name -> scores.v2.GetPeriodOverPeriodScoreChangeResponse
+	5,  // 15: scores.v2.DashboardResponse.categories_over_time:type_name -> scores.v2.CategoryScoreOverTime
+	10, // 16: scores.v2.DashboardResponse.worst_tickets:type_name -> scores.v2.TicketScore
+	1,  // 17: scores.v2.Scores.GetScoreByTicket:input_type -> scores.v2.DateRangeRequest
+	1,  // 18: scores.v2.Scores.GetAggregatedCategoryScoresOverTime:input_type -> scores.v2.DateRangeRequest
+	1,  // 19: scores.v2.Scores.GetOverAllQualityScore:input_type -> scores.v2.DateRangeRequest
+	1,  // 20: scores.v2.Scores.GetPeriodOverPeriodScoreChange:input_type -> scores.v2.DateRangeRequest
+	9,  // 21: scores.v2.Scores.GetDashboard:input_type -> scores.v2.DashboardRequest
+	3,  // 22: scores.v2.Scores.GetScoreByTicket:output_type -> scores.v2.ScoreByTicket
+	5,  // 23: scores.v2.Scores.GetAggregatedCategoryScoresOverTime:output_type -> scores.v2.CategoryScoreOverTime
+	6,  // 24: scores.v2.Scores.GetOverAllQualityScore:output_type -> scores.v2.OverAllQualityScoreResponse
+	8,  // 25: scores.v2.Scores.GetPeriodOverPeriodScoreChange:output_type -> scores.v2.GetPeriodOverPeriodScoreChangeResponse
+	11, // 26: scores.v2.Scores.GetDashboard:output_type -> scores.v2.DashboardResponse
+	22, // [22:27] is the sub-list for method output_type
+	17, // [17:22] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
+}
+
+func init() { file_scores_v2_scores_proto_init() }
+func file_scores_v2_scores_proto_init() {
+	if File_scores_v2_scores_proto != nil {
+		return
+	}
+	file_scores_v2_scores_proto_msgTypes[3].OneofWrappers = []any{}
+	file_scores_v2_scores_proto_msgTypes[4].OneofWrappers = []any{}
+	file_scores_v2_scores_proto_msgTypes[5].OneofWrappers = []any{}
+	file_scores_v2_scores_proto_msgTypes[6].OneofWrappers = []any{}
+	file_scores_v2_scores_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_scores_v2_scores_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_scores_v2_scores_proto_goTypes,
+		DependencyIndexes: file_scores_v2_scores_proto_depIdxs,
+		EnumInfos:         file_scores_v2_scores_proto_enumTypes,
+		MessageInfos:      file_scores_v2_scores_proto_msgTypes,
+	}.Build()
+	File_scores_v2_scores_proto = out.File
+	file_scores_v2_scores_proto_rawDesc = nil
+	file_scores_v2_scores_proto_goTypes = nil
+	file_scores_v2_scores_proto_depIdxs = nil
+}
@@ -2,9 +2,9 @@
 // versions:
 // - protoc-gen-go-grpc v1.5.1
 // - protoc             v3.12.4
-// source: scores.proto
+// source: scores/v2/scores.proto
 
-package grpc
+package scoresv2
 
 import (
 	context "context"
@@ -19,20 +19,32 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Scores_GetScoreByTicket_FullMethodName                    = "/grpc.Scores/GetScoreByTicket"
-	Scores_GetAggregatedCategoryScoresOverTime_FullMethodName = "/grpc.Scores/GetAggregatedCategoryScoresOverTime"
-	Scores_GetOverAllQualityScore_FullMethodName              = "/grpc.Scores/GetOverAllQualityScore"
-	Scores_GetPeriodOverPeriodScoreChange_FullMethodName      = "/grpc.Scores/GetPeriodOverPeriodScoreChange"
+	Scores_GetScoreByTicket_FullMethodName                    = "/scores.v2.Scores/GetScoreByTicket"
+	Scores_GetAggregatedCategoryScoresOverTime_FullMethodName = "/scores.v2.Scores/GetAggregatedCategoryScoresOverTime"
+	Scores_GetOverAllQualityScore_FullMethodName              = "/scores.v2.Scores/GetOverAllQualityScore"
+	Scores_GetPeriodOverPeriodScoreChange_FullMethodName      = "/scores.v2.Scores/GetPeriodOverPeriodScoreChange"
+	Scores_GetDashboard_FullMethodName                        = "/scores.v2.Scores/GetDashboard"
 )
 
 // ScoresClient is the client API for Scores service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Scores v2 has the same RPCs as scores.v1.Scores, served side by side with it, except
+// ExportReport, plus GetDashboard. It fixes what v1 cannot change without breaking its clients:
+//
+//   - every score is a double, so the two decimals the service rounds scores to are sent as they
+//     are instead of being widened from a float, e.g. 49.37 rather than 49.369999;
+//   - fields are lower_snake_case, so their JSON names are consistently lowerCamelCase, and
+//     repeated fields are plural.
 type ScoresClient interface {
 	GetScoreByTicket(ctx context.Context, in *DateRangeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ScoreByTicket], error)
 	GetAggregatedCategoryScoresOverTime(ctx context.Context, in *DateRangeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CategoryScoreOverTime], error)
 	GetOverAllQualityScore(ctx context.Context, in *DateRangeRequest, opts ...grpc.CallOption) (*OverAllQualityScoreResponse, error)
 	GetPeriodOverPeriodScoreChange(ctx context.Context, in *DateRangeRequest, opts ...grpc.CallOption) (*GetPeriodOverPeriodScoreChangeResponse, error)
+	// GetDashboard computes the requested sections in one call, concurrently and from one snapshot
+	// of the database, so they all count the same ratings.
+	GetDashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*DashboardResponse, error)
 }
 
 type scoresClient struct {
@@ -101,14 +113,35 @@ func (c *scoresClient) GetPeriodOverPeriodScoreChange(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *scoresClient) GetDashboard(ctx context.Context, in *DashboardRequest, opts ...grpc.CallOption) (*DashboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DashboardResponse)
+	err := c.cc.Invoke(ctx, Scores_GetDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScoresServer is the server API for Scores service.
 // All implementations must embed UnimplementedScoresServer
 // for forward compatibility.
+//
+// Scores v2 has the same RPCs as scores.v1.Scores, served side by side with it, except
+// ExportReport, plus GetDashboard. It fixes what v1 cannot change without breaking its clients:
+//
+//   - every score is a double, so the two decimals the service rounds scores to are sent as they
+//     are instead of being widened from a float, e.g. 49.37 rather than 49.369999;
+//   - fields are lower_snake_case, so their JSON names are consistently lowerCamelCase, and
+//     repeated fields are plural.
 type ScoresServer interface {
 	GetScoreByTicket(*DateRangeRequest, grpc.ServerStreamingServer[ScoreByTicket]) error
 	GetAggregatedCategoryScoresOverTime(*DateRangeRequest, grpc.ServerStreamingServer[CategoryScoreOverTime]) error
 	GetOverAllQualityScore(context.Context, *DateRangeRequest) (*OverAllQualityScoreResponse, error)
 	GetPeriodOverPeriodScoreChange(context.Context, *DateRangeRequest) (*GetPeriodOverPeriodScoreChangeResponse, error)
+	// GetDashboard computes the requested sections in one call, concurrently and from one snapshot
+	// of the database, so they all count the same ratings.
+	GetDashboard(context.Context, *DashboardRequest) (*DashboardResponse, error)
 	mustEmbedUnimplementedScoresServer()
 }
 
@@ -131,6 +164,9 @@ func (UnimplementedScoresServer) GetOverAllQualityScore(context.Context, *DateRa
 func (UnimplementedScoresServer) GetPeriodOverPeriodScoreChange(context.Context, *DateRangeRequest) (*GetPeriodOverPeriodScoreChangeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPeriodOverPeriodScoreChange not implemented")
 }
+func (UnimplementedScoresServer) GetDashboard(context.Context, *DashboardRequest) (*DashboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDashboard not implemented")
+}
 func (UnimplementedScoresServer) mustEmbedUnimplementedScoresServer() {}
 func (UnimplementedScoresServer) testEmbeddedByValue()                {}
 
@@ -210,11 +246,29 @@ func _Scores_GetPeriodOverPeriodScoreChange_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Scores_GetDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoresServer).GetDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scores_GetDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoresServer).GetDashboard(ctx, req.(*DashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Scores_ServiceDesc is the grpc.ServiceDesc for Scores service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
 var Scores_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "grpc.Scores",
+	ServiceName: "scores.v2.Scores",
 	HandlerType: (*ScoresServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -225,6 +279,10 @@ var Scores_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPeriodOverPeriodScoreChange",
 			Handler:    _Scores_GetPeriodOverPeriodScoreChange_Handler,
 		},
+		{
+			MethodName: "GetDashboard",
+			Handler:    _Scores_GetDashboard_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -238,5 +296,5 @@ var Scores_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 		},
 	},
-	Metadata: "scores.proto",
+	Metadata: "scores/v2/scores.proto",
 }
@@ -0,0 +1,227 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: scores/v2/scores.proto
+
+package scoresv2connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// ScoresName is the fully-qualified name of the Scores service.
+	ScoresName = "scores.v2.Scores"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// ScoresGetScoreByTicketProcedure is the fully-qualified name of the Scores's GetScoreByTicket RPC.
+	ScoresGetScoreByTicketProcedure = "/scores.v2.Scores/GetScoreByTicket"
+	// ScoresGetAggregatedCategoryScoresOverTimeProcedure is the fully-qualified name of the Scores's
+	// GetAggregatedCategoryScoresOverTime RPC.
+	ScoresGetAggregatedCategoryScoresOverTimeProcedure = "/scores.v2.Scores/GetAggregatedCategoryScoresOverTime"
+	// ScoresGetOverAllQualityScoreProcedure is the fully-qualified name of the Scores's
+	// GetOverAllQualityScore RPC.
+	ScoresGetOverAllQualityScoreProcedure = "/scores.v2.Scores/GetOverAllQualityScore"
+	// ScoresGetPeriodOverPeriodScoreChangeProcedure is the fully-qualified name of the Scores's
+	// GetPeriodOverPeriodScoreChange RPC.
+	ScoresGetPeriodOverPeriodScoreChangeProcedure = "/scores.v2.Scores/GetPeriodOverPeriodScoreChange"
+	// ScoresGetDashboardProcedure is the fully-qualified name of the Scores's GetDashboard RPC.
+	ScoresGetDashboardProcedure = "/scores.v2.Scores/GetDashboard"
+)
+
+// ScoresClient is a client for the scores.v2.Scores service.
+type ScoresClient interface {
+	GetScoreByTicket(context.Context, *connect.Request[v2.DateRangeRequest]) (*connect.ServerStreamForClient[v2.ScoreByTicket], error)
+	GetAggregatedCategoryScoresOverTime(context.Context, *connect.Request[v2.DateRangeRequest]) (*connect.ServerStreamForClient[v2.CategoryScoreOverTime], error)
+	GetOverAllQualityScore(context.Context, *connect.Request[v2.DateRangeRequest]) (*connect.Response[v2.OverAllQualityScoreResponse], error)
+	GetPeriodOverPeriodScoreChange(context.Context, *connect.Request[v2.DateRangeRequest]) (*connect.Response[v2.GetPeriodOverPeriodScoreChangeResponse], error)
+	// GetDashboard computes the requested sections in one call, concurrently and from one snapshot
+	// of the database, so they all count the same ratings.
+	GetDashboard(context.Context, *connect.Request[v2.DashboardRequest]) (*connect.Response[v2.DashboardResponse], error)
+}
+
+// NewScoresClient constructs a client for the scores.v2.Scores service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewScoresClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) ScoresClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	scoresMethods := v2.File_scores_v2_scores_proto.Services().ByName("Scores").Methods()
+	return &scoresClient{
+		getScoreByTicket: connect.NewClient[v2.DateRangeRequest, v2.ScoreByTicket](
+			httpClient,
+			baseURL+ScoresGetScoreByTicketProcedure,
+			connect.WithSchema(scoresMethods.ByName("GetScoreByTicket")),
+			connect.WithClientOptions(opts...),
+		),
+		getAggregatedCategoryScoresOverTime: connect.NewClient[v2.DateRangeRequest, v2.CategoryScoreOverTime](
+			httpClient,
+			baseURL+ScoresGetAggregatedCategoryScoresOverTimeProcedure,
+			connect.WithSchema(scoresMethods.ByName("GetAggregatedCategoryScoresOverTime")),
+			connect.WithClientOptions(opts...),
+		),
+		getOverAllQualityScore: connect.NewClient[v2.DateRangeRequest, v2.OverAllQualityScoreResponse](
+			httpClient,
+			baseURL+ScoresGetOverAllQualityScoreProcedure,
+			connect.WithSchema(scoresMethods.ByName("GetOverAllQualityScore")),
+			connect.WithClientOptions(opts...),
+		),
+		getPeriodOverPeriodScoreChange: connect.NewClient[v2.DateRangeRequest, v2.GetPeriodOverPeriodScoreChangeResponse](
+			httpClient,
+			baseURL+ScoresGetPeriodOverPeriodScoreChangeProcedure,
+			connect.WithSchema(scoresMethods.ByName("GetPeriodOverPeriodScoreChange")),
+			connect.WithClientOptions(opts...),
+		),
+		getDashboard: connect.NewClient[v2.DashboardRequest, v2.DashboardResponse](
+			httpClient,
+			baseURL+ScoresGetDashboardProcedure,
+			connect.WithSchema(scoresMethods.ByName("GetDashboard")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// scoresClient implements ScoresClient.
+type scoresClient struct {
+	getScoreByTicket                    *connect.Client[v2.DateRangeRequest, v2.ScoreByTicket]
+	getAggregatedCategoryScoresOverTime *connect.Client[v2.DateRangeRequest, v2.CategoryScoreOverTime]
+	getOverAllQualityScore              *connect.Client[v2.DateRangeRequest, v2.OverAllQualityScoreResponse]
+	getPeriodOverPeriodScoreChange      *connect.Client[v2.DateRangeRequest, v2.GetPeriodOverPeriodScoreChangeResponse]
+	getDashboard                        *connect.Client[v2.DashboardRequest, v2.DashboardResponse]
+}
+
+// GetScoreByTicket calls scores.v2.Scores.GetScoreByTicket.
+func (c *scoresClient) GetScoreByTicket(ctx context.Context, req *connect.Request[v2.DateRangeRequest]) (*connect.ServerStreamForClient[v2.ScoreByTicket], error) {
+	return c.getScoreByTicket.CallServerStream(ctx, req)
+}
+
+// GetAggregatedCategoryScoresOverTime calls scores.v2.Scores.GetAggregatedCategoryScoresOverTime.
+func (c *scoresClient) GetAggregatedCategoryScoresOverTime(ctx context.Context, req *connect.Request[v2.DateRangeRequest]) (*connect.ServerStreamForClient[v2.CategoryScoreOverTime], error) {
+	return c.getAggregatedCategoryScoresOverTime.CallServerStream(ctx, req)
+}
+
+// GetOverAllQualityScore calls scores.v2.Scores.GetOverAllQualityScore.
+func (c *scoresClient) GetOverAllQualityScore(ctx context.Context, req *connect.Request[v2.DateRangeRequest]) (*connect.Response[v2.OverAllQualityScoreResponse], error) {
+	return c.getOverAllQualityScore.CallUnary(ctx, req)
+}
+
+// GetPeriodOverPeriodScoreChange calls scores.v2.Scores.GetPeriodOverPeriodScoreChange.
+func (c *scoresClient) GetPeriodOverPeriodScoreChange(ctx context.Context, req *connect.Request[v2.DateRangeRequest]) (*connect.Response[v2.GetPeriodOverPeriodScoreChangeResponse], error) {
+	return c.getPeriodOverPeriodScoreChange.CallUnary(ctx, req)
+}
+
+// GetDashboard calls scores.v2.Scores.GetDashboard.
+func (c *scoresClient) GetDashboard(ctx context.Context, req *connect.Request[v2.DashboardRequest]) (*connect.Response[v2.DashboardResponse], error) {
+	return c.getDashboard.CallUnary(ctx, req)
+}
+
+// ScoresHandler is an implementation of the scores.v2.Scores service.
+type ScoresHandler interface {
+	GetScoreByTicket(context.Context, *connect.Request[v2.DateRangeRequest], *connect.ServerStream[v2.ScoreByTicket]) error
+	GetAggregatedCategoryScoresOverTime(context.Context, *connect.Request[v2.DateRangeRequest], *connect.ServerStream[v2.CategoryScoreOverTime]) error
+	GetOverAllQualityScore(context.Context, *connect.Request[v2.DateRangeRequest]) (*connect.Response[v2.OverAllQualityScoreResponse], error)
+	GetPeriodOverPeriodScoreChange(context.Context, *connect.Request[v2.DateRangeRequest]) (*connect.Response[v2.GetPeriodOverPeriodScoreChangeResponse], error)
+	// GetDashboard computes the requested sections in one call, concurrently and from one snapshot
+	// of the database, so they all count the same ratings.
+	GetDashboard(context.Context, *connect.Request[v2.DashboardRequest]) (*connect.Response[v2.DashboardResponse], error)
+}
+
+// NewScoresHandler builds an HTTP handler from the service implementation. It returns the path on
+// which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewScoresHandler(svc ScoresHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	scoresMethods := v2.File_scores_v2_scores_proto.Services().ByName("Scores").Methods()
+	scoresGetScoreByTicketHandler := connect.NewServerStreamHandler(
+		ScoresGetScoreByTicketProcedure,
+		svc.GetScoreByTicket,
+		connect.WithSchema(scoresMethods.ByName("GetScoreByTicket")),
+		connect.WithHandlerOptions(opts...),
+	)
+	scoresGetAggregatedCategoryScoresOverTimeHandler := connect.NewServerStreamHandler(
+		ScoresGetAggregatedCategoryScoresOverTimeProcedure,
+		svc.GetAggregatedCategoryScoresOverTime,
+		connect.WithSchema(scoresMethods.ByName("GetAggregatedCategoryScoresOverTime")),
+		connect.WithHandlerOptions(opts...),
+	)
+	scoresGetOverAllQualityScoreHandler := connect.NewUnaryHandler(
+		ScoresGetOverAllQualityScoreProcedure,
+		svc.GetOverAllQualityScore,
+		connect.WithSchema(scoresMethods.ByName("GetOverAllQualityScore")),
+		connect.WithHandlerOptions(opts...),
+	)
+	scoresGetPeriodOverPeriodScoreChangeHandler := connect.NewUnaryHandler(
+		ScoresGetPeriodOverPeriodScoreChangeProcedure,
+		svc.GetPeriodOverPeriodScoreChange,
+		connect.WithSchema(scoresMethods.ByName("GetPeriodOverPeriodScoreChange")),
+		connect.WithHandlerOptions(opts...),
+	)
+	scoresGetDashboardHandler := connect.NewUnaryHandler(
+		ScoresGetDashboardProcedure,
+		svc.GetDashboard,
+		connect.WithSchema(scoresMethods.ByName("GetDashboard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/scores.v2.Scores/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case ScoresGetScoreByTicketProcedure:
+			scoresGetScoreByTicketHandler.ServeHTTP(w, r)
+		case ScoresGetAggregatedCategoryScoresOverTimeProcedure:
+			scoresGetAggregatedCategoryScoresOverTimeHandler.ServeHTTP(w, r)
+		case ScoresGetOverAllQualityScoreProcedure:
+			scoresGetOverAllQualityScoreHandler.ServeHTTP(w, r)
+		case ScoresGetPeriodOverPeriodScoreChangeProcedure:
+			scoresGetPeriodOverPeriodScoreChangeHandler.ServeHTTP(w, r)
+		case ScoresGetDashboardProcedure:
+			scoresGetDashboardHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedScoresHandler returns CodeUnimplemented from all methods.
+type UnimplementedScoresHandler struct{}
+
+func (UnimplementedScoresHandler) GetScoreByTicket(context.Context, *connect.Request[v2.DateRangeRequest], *connect.ServerStream[v2.ScoreByTicket]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("scores.v2.Scores.GetScoreByTicket is not implemented"))
+}
+
+func (UnimplementedScoresHandler) GetAggregatedCategoryScoresOverTime(context.Context, *connect.Request[v2.DateRangeRequest], *connect.ServerStream[v2.CategoryScoreOverTime]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("scores.v2.Scores.GetAggregatedCategoryScoresOverTime is not implemented"))
+}
+
+func (UnimplementedScoresHandler) GetOverAllQualityScore(context.Context, *connect.Request[v2.DateRangeRequest]) (*connect.Response[v2.OverAllQualityScoreResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scores.v2.Scores.GetOverAllQualityScore is not implemented"))
+}
+
+func (UnimplementedScoresHandler) GetPeriodOverPeriodScoreChange(context.Context, *connect.Request[v2.DateRangeRequest]) (*connect.Response[v2.GetPeriodOverPeriodScoreChangeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scores.v2.Scores.GetPeriodOverPeriodScoreChange is not implemented"))
+}
+
+func (UnimplementedScoresHandler) GetDashboard(context.Context, *connect.Request[v2.DashboardRequest]) (*connect.Response[v2.DashboardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("scores.v2.Scores.GetDashboard is not implemented"))
+}
@@ -0,0 +1,53 @@
+package ratelimit
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ClientAddressHeader carries the address of the HTTP client a gateway call is made for, since
+// the gRPC peer of every such call is the gateway itself.
+const ClientAddressHeader = "x-client-address"
+
+type clientAddressKey struct{}
+
+// WithClientAddress returns a copy of ctx whose unauthenticated calls are limited as coming from
+// address.
+func WithClientAddress(ctx context.Context, address string) context.Context {
+	return context.WithValue(ctx, clientAddressKey{}, address)
+}
+
+// UnaryServerInterceptor limits every call by the client address the gateway forwarded in
+// ClientAddressHeader. It must only be installed on the in-process server behind the gateway,
+// which sets the header itself; anywhere else clients could pick their own bucket.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(forwardedClientAddress(ctx), req)
+	}
+}
+
+// StreamServerInterceptor limits every stream by its forwarded client address, see
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &clientAddressServerStream{ServerStream: ss, ctx: forwardedClientAddress(ss.Context())})
+	}
+}
+
+func forwardedClientAddress(ctx context.Context) context.Context {
+	if values := metadata.ValueFromIncomingContext(ctx, ClientAddressHeader); len(values) > 0 && values[0] != "" {
+		return WithClientAddress(ctx, values[0])
+	}
+	return ctx
+}
+
+type clientAddressServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *clientAddressServerStream) Context() context.Context {
+	return stream.ctx
+}
@@ -0,0 +1,180 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	"github.com/fernandoalava/softwareengineer-test-task/metrics"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/peer"
+)
+
+// Options configures a Limiter. Costs are in the unit returned by EstimateCost.
+type Options struct {
+	// CostPerSecond is refilled into every client's token bucket each second, zero disables the
+	// per-client limit.
+	CostPerSecond int
+	// Burst is the size of every client's bucket, calls costing more are charged Burst.
+	Burst int
+	// HeavyCost is the cost from which a call counts as a heavy query.
+	HeavyCost int
+	// MaxConcurrentHeavy heavy queries run at once, zero disables the cap.
+	MaxConcurrentHeavy int
+	// MaxQueuedHeavy heavy queries wait for a slot, for at most QueueTimeout; more are rejected.
+	MaxQueuedHeavy int
+	QueueTimeout   time.Duration
+}
+
+// Limiter enforces per-client token buckets and a global cap on concurrent heavy queries.
+type Limiter struct {
+	options Options
+
+	mutex     sync.Mutex
+	clients   map[string]*client
+	lastSweep time.Time
+
+	heavy  chan struct{}
+	queued atomic.Int64
+}
+
+type client struct {
+	bucket   *rate.Limiter
+	lastSeen time.Time
+}
+
+func NewLimiter(options Options) *Limiter {
+	limiter := &Limiter{options: options, clients: map[string]*client{}, lastSweep: time.Now()}
+	if options.MaxConcurrentHeavy > 0 {
+		limiter.heavy = make(chan struct{}, options.MaxConcurrentHeavy)
+	}
+	return limiter
+}
+
+// EstimateCost approximates the work of method over [from, to) in days of ratings scanned: one
+// per started day, twice as many for the period over period change which also reads the previous
+// period, and one more per bucket, daily or weekly, of the aggregated scores.
+func EstimateCost(method string, from, to time.Time) int {
+	days := max(int(math.Ceil(to.Sub(from).Hours()/24)), 1)
+	switch method {
+	case "GetPeriodOverPeriodScoreChange":
+		return 2 * days
+	case "GetAggregatedCategoryScoresOverTime":
+		return days + len(util.GenerateDateRanges(from, to))
+	default:
+		return days
+	}
+}
+
+// Admit charges cost to the caller of ctx and, for heavy queries, waits for a free slot. The
+// returned release must be called once the call is done. Rejections are
+// apperror.ResourceExhaustedError telling the client how long to back off.
+func (limiter *Limiter) Admit(ctx context.Context, method string, cost int) (release func(), err error) {
+	var reservation *rate.Reservation
+	if limiter.options.CostPerSecond > 0 {
+		now := time.Now()
+		reservation = limiter.bucket(ctx, now).ReserveN(now, min(cost, limiter.options.Burst))
+		if delay := reservation.DelayFrom(now); delay > 0 {
+			reservation.CancelAt(now)
+			metrics.ObserveRateLimited(method, "rate")
+			return nil, &apperror.ResourceExhaustedError{Reason: "rate limit exceeded", RetryAfter: delay}
+		}
+	}
+	if limiter.heavy == nil || cost < limiter.options.HeavyCost {
+		return func() {}, nil
+	}
+	release, err = limiter.acquireHeavy(ctx, method)
+	if err != nil && reservation != nil {
+		// The query never ran, so the client gets its tokens back.
+		reservation.Cancel()
+	}
+	return release, err
+}
+
+func (limiter *Limiter) acquireHeavy(ctx context.Context, method string) (func(), error) {
+	release := func() {
+		<-limiter.heavy
+		metrics.AddHeavyQueries("running", -1)
+	}
+	select {
+	case limiter.heavy <- struct{}{}:
+		metrics.AddHeavyQueries("running", 1)
+		return release, nil
+	default:
+	}
+
+	if limiter.queued.Add(1) > int64(limiter.options.MaxQueuedHeavy) {
+		limiter.queued.Add(-1)
+		metrics.ObserveRateLimited(method, "queue_full")
+		return nil, &apperror.ResourceExhaustedError{Reason: "too many heavy queries", RetryAfter: limiter.options.QueueTimeout}
+	}
+	metrics.AddHeavyQueries("queued", 1)
+	defer func() {
+		limiter.queued.Add(-1)
+		metrics.AddHeavyQueries("queued", -1)
+	}()
+
+	timer := time.NewTimer(limiter.options.QueueTimeout)
+	defer timer.Stop()
+	select {
+	case limiter.heavy <- struct{}{}:
+		metrics.AddHeavyQueries("running", 1)
+		return release, nil
+	case <-timer.C:
+		metrics.ObserveRateLimited(method, "queue_timeout")
+		return nil, &apperror.ResourceExhaustedError{Reason: "timed out waiting for a heavy query slot", RetryAfter: limiter.options.QueueTimeout}
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// bucket returns the token bucket of the caller of ctx, dropping the buckets of clients idle for
+// long enough to have refilled completely, which are no different from new ones.
+func (limiter *Limiter) bucket(ctx context.Context, now time.Time) *rate.Limiter {
+	idle := time.Duration(float64(limiter.options.Burst) / float64(limiter.options.CostPerSecond) * float64(time.Second))
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	if now.Sub(limiter.lastSweep) > max(idle, time.Minute) {
+		for key, client := range limiter.clients {
+			if now.Sub(client.lastSeen) > idle {
+				delete(limiter.clients, key)
+			}
+		}
+		limiter.lastSweep = now
+	}
+
+	key := clientKey(ctx)
+	c, ok := limiter.clients[key]
+	if !ok {
+		c = &client{bucket: rate.NewLimiter(rate.Limit(limiter.options.CostPerSecond), limiter.options.Burst)}
+		limiter.clients[key] = c
+	}
+	c.lastSeen = now
+	return c.bucket
+}
+
+// clientKey identifies authenticated callers by their credentials and others by their address,
+// the one forwarded by the gateway for its calls.
+func clientKey(ctx context.Context) string {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return fmt.Sprintf("%s:%d:%s", identity.Method, identity.WorkspaceID, identity.Subject)
+	}
+	if address, ok := ctx.Value(clientAddressKey{}).(string); ok {
+		return "ip:" + address
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return "ip:" + host
+		}
+		return "addr:" + p.Addr.String()
+	}
+	return "anonymous"
+}
@@ -0,0 +1,81 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+)
+
+type scoreService interface {
+	GetScoreByTicket(ctx context.Context, from time.Time, to time.Time) ([]service.TicketScoreByCategory, error)
+	GetAggregatedCategoryScoresOverTime(ctx context.Context, from time.Time, to time.Time) ([]service.CategoryScoreOverTime, error)
+	GetOverAllQualityScore(ctx context.Context, from time.Time, to time.Time) (*float64, error)
+	GetPeriodOverPeriodScoreChange(ctx context.Context, from time.Time, to time.Time) (*service.GetPeriodOverPeriodScoreChangeResponse, error)
+	GetDashboard(ctx context.Context, from time.Time, to time.Time, sections service.DashboardSections) (*service.Dashboard, error)
+}
+
+// ScoreService admits every call through the limiter, with the cost of its resolved range,
+// before delegating to the wrapped service.
+type ScoreService struct {
+	limiter *Limiter
+	next    scoreService
+}
+
+func NewScoreService(limiter *Limiter, next scoreService) *ScoreService {
+	return &ScoreService{limiter: limiter, next: next}
+}
+
+func (scoreService *ScoreService) admit(ctx context.Context, method string, from, to time.Time) (func(), error) {
+	return scoreService.limiter.Admit(ctx, method, EstimateCost(method, from, to))
+}
+
+func (scoreService *ScoreService) GetScoreByTicket(ctx context.Context, from time.Time, to time.Time) ([]service.TicketScoreByCategory, error) {
+	release, err := scoreService.admit(ctx, "GetScoreByTicket", from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return scoreService.next.GetScoreByTicket(ctx, from, to)
+}
+
+func (scoreService *ScoreService) GetAggregatedCategoryScoresOverTime(ctx context.Context, from time.Time, to time.Time) ([]service.CategoryScoreOverTime, error) {
+	release, err := scoreService.admit(ctx, "GetAggregatedCategoryScoresOverTime", from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return scoreService.next.GetAggregatedCategoryScoresOverTime(ctx, from, to)
+}
+
+func (scoreService *ScoreService) GetOverAllQualityScore(ctx context.Context, from time.Time, to time.Time) (*float64, error) {
+	release, err := scoreService.admit(ctx, "GetOverAllQualityScore", from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return scoreService.next.GetOverAllQualityScore(ctx, from, to)
+}
+
+func (scoreService *ScoreService) GetPeriodOverPeriodScoreChange(ctx context.Context, from time.Time, to time.Time) (*service.GetPeriodOverPeriodScoreChangeResponse, error) {
+	release, err := scoreService.admit(ctx, "GetPeriodOverPeriodScoreChange", from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return scoreService.next.GetPeriodOverPeriodScoreChange(ctx, from, to)
+}
+
+// GetDashboard is charged the cost of all the calls its sections replace at once.
+func (scoreService *ScoreService) GetDashboard(ctx context.Context, from time.Time, to time.Time, sections service.DashboardSections) (*service.Dashboard, error) {
+	cost := 0
+	for _, method := range sections.Methods() {
+		cost += EstimateCost(method, from, to)
+	}
+	release, err := scoreService.limiter.Admit(ctx, "GetDashboard", cost)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	return scoreService.next.GetDashboard(ctx, from, to, sections)
+}
@@ -0,0 +1,168 @@
+package report
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+)
+
+// NotAvailable is shown for periods and categories without ratings.
+const NotAvailable = "N/A"
+
+// Score is a percentage from 0 to 100, and Change a relative change where 1 is +100%.
+type (
+	Score  float64
+	Change float64
+)
+
+// Table is a report laid out like the UI tables of the task description. Cells are strings,
+// integers, time.Time, Score or Change values, or nil for NotAvailable. Other values are
+// formatted with fmt.Sprint, and written to XLSX as they are.
+type Table struct {
+	Header []string
+	Rows   [][]any
+}
+
+// Strings returns the header and the rows with every cell formatted as text.
+func (t *Table) Strings() [][]string {
+	lines := [][]string{t.Header}
+	for _, row := range t.Rows {
+		line := make([]string, len(row))
+		for i, cell := range row {
+			line[i] = formatCell(cell)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func formatCell(cell any) string {
+	switch value := cell.(type) {
+	case nil:
+		return NotAvailable
+	case string:
+		return value
+	case int64:
+		return strconv.FormatInt(value, 10)
+	case int:
+		return strconv.Itoa(value)
+	case time.Time:
+		return value.UTC().Format(time.RFC3339)
+	case Score:
+		return formatPercent(float64(value))
+	case Change:
+		change := formatPercent(float64(value) * 100)
+		if value > 0 {
+			return "+" + change
+		}
+		return change
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+func formatPercent(value float64) string {
+	return strconv.FormatFloat(util.FormatScore(value), 'f', -1, 64) + "%"
+}
+
+// Tickets has a row per ticket and a column per rating category, ordered by ID.
+func Tickets(tickets []*pb.ScoreByTicket) *Table {
+	names := map[int64]string{}
+	for _, ticket := range tickets {
+		for _, category := range ticket.GetRatingCategoryScore() {
+			names[category.GetRatingCategoryID()] = category.GetRatingCategoryName()
+		}
+	}
+	ids := make([]int64, 0, len(names))
+	for id := range names {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	table := &Table{Header: []string{"Ticket ID"}}
+	for _, id := range ids {
+		table.Header = append(table.Header, names[id])
+	}
+	for _, ticket := range tickets {
+		scores := map[int64]float32{}
+		for _, category := range ticket.GetRatingCategoryScore() {
+			scores[category.GetRatingCategoryID()] = category.GetScore()
+		}
+		row := []any{ticket.GetTicketId()}
+		for _, id := range ids {
+			if score, ok := scores[id]; ok {
+				row = append(row, Score(score))
+			} else {
+				row = append(row, nil)
+			}
+		}
+		table.Rows = append(table.Rows, row)
+	}
+	return table
+}
+
+// CategoriesOverTime has a row per rating category and a column per daily or weekly bucket,
+// named after its first day, with NotAvailable for buckets without a score.
+func CategoriesOverTime(categories []*pb.CategoryScoreOverTime) *Table {
+	table := &Table{Header: []string{"Category", "Ratings"}}
+	if len(categories) > 0 {
+		for _, period := range categories[0].GetPeriodScoreWithRatings() {
+			table.Header = append(table.Header, period.GetFrom().AsTime().Format(time.DateOnly))
+		}
+	}
+	table.Header = append(table.Header, "Score")
+	for _, category := range categories {
+		row := []any{category.GetCategoryName(), int(category.GetTotalRating())}
+		for _, period := range category.GetPeriodScoreWithRatings() {
+			row = append(row, optionalScore(period.Score))
+		}
+		table.Rows = append(table.Rows, append(row, optionalScore(category.TotalScore)))
+	}
+	return table
+}
+
+// optionalScore is nil, shown as NotAvailable, when score is unset.
+func optionalScore(score *float32) any {
+	if score == nil {
+		return nil
+	}
+	return Score(*score)
+}
+
+// Overall is the overall quality score of [from, to).
+func Overall(from, to time.Time, response *pb.OverAllQualityScoreResponse) *Table {
+	return &Table{
+		Header: []string{"From", "To", "Score"},
+		Rows:   [][]any{{from, to, optionalScore(response.OverAllScore)}},
+	}
+}
+
+// PeriodOverPeriod shows both periods and the relative change between them, NotAvailable for
+// periods without ratings and changes that cannot be computed. It takes the v2 response, since
+// v1 sends those as 0.
+func PeriodOverPeriod(response *pbv2.GetPeriodOverPeriodScoreChangeResponse) *Table {
+	period := func(name string, score *pbv2.PeriodScore) []any {
+		var cell any
+		if score.Score != nil {
+			cell = Score(score.GetScore())
+		}
+		return []any{name, score.GetFrom().AsTime(), score.GetTo().AsTime(), cell}
+	}
+	var change any
+	if response.ScoreDifference != nil {
+		change = Change(response.GetScoreDifference())
+	}
+	return &Table{
+		Header: []string{"Period", "From", "To", "Score"},
+		Rows: [][]any{
+			period("Current", response.GetCurrentPeriod()),
+			period("Previous", response.GetPreviousPeriod()),
+			{"Change", "", "", change},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package report
+
+import (
+	"encoding/csv"
+	"io"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	CSVContentType  = "text/csv"
+	XLSXContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// WriteCSV writes the table with every cell formatted as text, as Strings does.
+func (t *Table) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	return writer.WriteAll(t.Strings())
+}
+
+// WriteXLSX writes the table as a workbook with a single sheet. Scores and changes are
+// percentage cells and dates are date cells, so they can be sorted and charted.
+func (t *Table) WriteXLSX(w io.Writer, sheet string) error {
+	file := excelize.NewFile()
+	defer file.Close()
+	if err := file.SetSheetName(file.GetSheetName(0), sheet); err != nil {
+		return err
+	}
+	bold, err := file.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	if err != nil {
+		return err
+	}
+	// Built-in format 10 is "0.00%".
+	percent, err := file.NewStyle(&excelize.Style{NumFmt: 10})
+	if err != nil {
+		return err
+	}
+	dateFormat := "yyyy-mm-dd hh:mm"
+	date, err := file.NewStyle(&excelize.Style{CustomNumFmt: &dateFormat})
+	if err != nil {
+		return err
+	}
+
+	writer, err := file.NewStreamWriter(sheet)
+	if err != nil {
+		return err
+	}
+	if err := writer.SetColWidth(1, max(len(t.Header), 1), 16); err != nil {
+		return err
+	}
+	if err := writer.SetPanes(&excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"}); err != nil {
+		return err
+	}
+	header := make([]any, len(t.Header))
+	for i, name := range t.Header {
+		header[i] = excelize.Cell{StyleID: bold, Value: name}
+	}
+	if err := writer.SetRow("A1", header); err != nil {
+		return err
+	}
+	for i, row := range t.Rows {
+		cells := make([]any, len(row))
+		for j, cell := range row {
+			switch value := cell.(type) {
+			case nil:
+				cells[j] = NotAvailable
+			case Score:
+				cells[j] = excelize.Cell{StyleID: percent, Value: util.FormatScore(float64(value)) / 100}
+			case Change:
+				cells[j] = excelize.Cell{StyleID: percent, Value: float64(value)}
+			case time.Time:
+				cells[j] = excelize.Cell{StyleID: date, Value: value.UTC()}
+			default:
+				cells[j] = value
+			}
+		}
+		cell, err := excelize.CoordinatesToCellName(1, i+2)
+		if err != nil {
+			return err
+		}
+		if err := writer.SetRow(cell, cells); err != nil {
+			return err
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	return file.Write(w)
+}
@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+)
+
+// migrations are applied in order; the number of applied migrations is stored in
+// PRAGMA user_version.
+var migrations = [][]string{
+	// 1: workspaces. Existing rows belong to workspace 1, see workspace.DefaultID.
+	{
+		"ALTER TABLE tickets ADD COLUMN workspace_id INTEGER NOT NULL DEFAULT 1",
+		"ALTER TABLE rating_categories ADD COLUMN workspace_id INTEGER NOT NULL DEFAULT 1",
+		"ALTER TABLE ratings ADD COLUMN workspace_id INTEGER NOT NULL DEFAULT 1",
+		"CREATE INDEX IF NOT EXISTS ratings_workspace_created_at ON ratings (workspace_id, created_at)",
+		"CREATE INDEX IF NOT EXISTS rating_categories_workspace ON rating_categories (workspace_id)",
+	},
+}
+
+// Migrate brings the schema up to date. conn must be writable.
+func Migrate(ctx context.Context, conn *sql.DB) error {
+	var version int
+	if err := conn.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return apperror.NewDatabaseError("Migrate", err)
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("database schema version %d is newer than this release supports (%d)", version, len(migrations))
+	}
+	for ; version < len(migrations); version++ {
+		if err := migrate(ctx, conn, version+1, migrations[version]); err != nil {
+			return err
+		}
+		slog.InfoContext(ctx, "applied database migration", "version", version+1)
+	}
+	return nil
+}
+
+func migrate(ctx context.Context, conn *sql.DB, version int, statements []string) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return apperror.NewDatabaseError("Migrate", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("migration %d: %w", version, err)
+		}
+	}
+	// PRAGMA does not accept bound parameters.
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", version)); err != nil {
+		return fmt.Errorf("migration %d: %w", version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return apperror.NewDatabaseError("Migrate", err)
+	}
+	return nil
+}
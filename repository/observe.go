@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/metrics"
+	"github.com/fernandoalava/softwareengineer-test-task/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SlowQueryThreshold is the duration above which a query is logged as slow, zero or a negative
+// value logs every query.
+var SlowQueryThreshold = 500 * time.Millisecond
+
+// observeQuery starts a span for a repository query and starts measuring it, the returned
+// function records its duration and the number of returned rows, ends the span and logs the
+// query if it was slow.
+func observeQuery(ctx context.Context, method string, params ...attribute.KeyValue) (context.Context, func(rows int, err error)) {
+	start := time.Now()
+	ctx, span := tracing.Tracer().Start(ctx, method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system", "sqlite")),
+		trace.WithAttributes(params...),
+	)
+	return ctx, func(rows int, err error) {
+		duration := time.Since(start)
+		metrics.ObserveQuery(method, duration, rows, err)
+		span.SetAttributes(attribute.Int("db.response.returned_rows", rows))
+		tracing.End(span, err)
+
+		if duration >= SlowQueryThreshold {
+			attrs := []any{"query", method, "duration", duration, "rows", rows}
+			for _, param := range params {
+				attrs = append(attrs, string(param.Key), param.Value.AsInterface())
+			}
+			slog.WarnContext(ctx, "slow query", attrs...)
+		}
+	}
+}
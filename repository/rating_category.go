@@ -3,8 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
-	"log"
+	"log/slog"
 
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
 	"github.com/fernandoalava/softwareengineer-test-task/domain"
 )
 
@@ -16,22 +17,28 @@ func NewRatingCategoryRepository(conn *sql.DB) *RatingCategoryRepository {
 	return &RatingCategoryRepository{conn}
 }
 
-func (repository *RatingCategoryRepository) FetchAll(ctx context.Context) ([]domain.RatingCategory, error) {
-	query := "SELECT id, name, weight FROM rating_categories"
-	rows, err := repository.Conn.QueryContext(ctx, query)
+func (repository *RatingCategoryRepository) FetchAll(ctx context.Context) (result []domain.RatingCategory, err error) {
+	workspaceID, workspaceAttribute, err := requireWorkspace(ctx, "RatingCategoryRepository.FetchAll")
 	if err != nil {
-		log.Println("error while querying rating_categories table", err)
 		return nil, err
 	}
+	ctx, done := observeQuery(ctx, "RatingCategoryRepository.FetchAll", workspaceAttribute)
+	defer func() { done(len(result), err) }()
+
+	query := "SELECT id, name, weight FROM rating_categories WHERE workspace_id = ? ORDER BY id"
+	rows, err := queryerOf(ctx, repository.Conn).QueryContext(ctx, query, workspaceID)
+	if err != nil {
+		slog.ErrorContext(ctx, "error while querying rating_categories table", "error", err)
+		return nil, apperror.NewDatabaseError("RatingCategoryRepository.FetchAll", err)
+	}
 
 	defer func() {
 		errRow := rows.Close()
 		if errRow != nil {
-			log.Println("error trying to close rows", err)
+			slog.ErrorContext(ctx, "error trying to close rows", "error", errRow)
 		}
 	}()
 
-	var result []domain.RatingCategory
 	for rows.Next() {
 		var ratingCategory = domain.RatingCategory{}
 		err = rows.Scan(
@@ -40,11 +47,15 @@ func (repository *RatingCategoryRepository) FetchAll(ctx context.Context) ([]dom
 			&ratingCategory.Weight,
 		)
 		if err != nil {
-			return nil, err
+			return nil, apperror.NewDatabaseError("RatingCategoryRepository.FetchAll", err)
 		}
 		result = append(result, ratingCategory)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, apperror.NewDatabaseError("RatingCategoryRepository.FetchAll", err)
+	}
+
 	return result, nil
 
 }
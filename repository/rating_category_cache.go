@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/domain"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
+)
+
+type ratingCategoryFetcher interface {
+	FetchAll(ctx context.Context) ([]domain.RatingCategory, error)
+}
+
+type cachedRatingCategories struct {
+	fetchedAt  time.Time
+	categories []domain.RatingCategory
+}
+
+// CachedRatingCategoryRepository keeps the rating categories of every workspace in memory for
+// ttl, they rarely change and are read by every aggregated scores request.
+type CachedRatingCategoryRepository struct {
+	repository ratingCategoryFetcher
+	ttl        time.Duration
+
+	mutex  sync.Mutex
+	cached map[int64]cachedRatingCategories
+}
+
+func NewCachedRatingCategoryRepository(repository ratingCategoryFetcher, ttl time.Duration) *CachedRatingCategoryRepository {
+	return &CachedRatingCategoryRepository{repository: repository, ttl: ttl, cached: map[int64]cachedRatingCategories{}}
+}
+
+func (repository *CachedRatingCategoryRepository) FetchAll(ctx context.Context) ([]domain.RatingCategory, error) {
+	workspaceID, ok := workspace.FromContext(ctx)
+	if !ok {
+		return repository.repository.FetchAll(ctx)
+	}
+	// Snapshots read the categories as they were when they started, like the ratings they score.
+	if _, inSnapshot := ctx.Value(snapshotKey{}).(*sql.Tx); inSnapshot {
+		return repository.repository.FetchAll(ctx)
+	}
+
+	repository.mutex.Lock()
+	entry, ok := repository.cached[workspaceID]
+	repository.mutex.Unlock()
+	if ok && time.Since(entry.fetchedAt) < repository.ttl {
+		return slices.Clone(entry.categories), nil
+	}
+
+	// The lock is not held while querying, so a slow query does not hold up other workspaces;
+	// concurrent misses may query more than once, the last result is kept.
+	categories, err := repository.repository.FetchAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	repository.mutex.Lock()
+	repository.cached[workspaceID] = cachedRatingCategories{fetchedAt: time.Now(), categories: categories}
+	repository.mutex.Unlock()
+	// Callers get copies, so sorting or changing them does not change the cached ones.
+	return slices.Clone(categories), nil
+}
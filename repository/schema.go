@@ -0,0 +1,49 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+)
+
+// requiredColumns lists the tables and columns the repositories query.
+var requiredColumns = map[string][]string{
+	"rating_categories": {"id", "name", "weight", "workspace_id"},
+	"ratings":           {"rating", "ticket_id", "rating_category_id", "reviewee_id", "created_at", "workspace_id"},
+	"tickets":           {"id", "workspace_id"},
+}
+
+// VerifySchema checks that every table and column used by the repositories exists.
+func VerifySchema(ctx context.Context, conn *sql.DB) error {
+	for table, columns := range requiredColumns {
+		rows, err := conn.QueryContext(ctx, "SELECT name FROM pragma_table_info(?)", table)
+		if err != nil {
+			return apperror.NewDatabaseError("VerifySchema", err)
+		}
+		existing := map[string]bool{}
+		for rows.Next() {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				_ = rows.Close()
+				return apperror.NewDatabaseError("VerifySchema", err)
+			}
+			existing[name] = true
+		}
+		if err := rows.Close(); err != nil {
+			return apperror.NewDatabaseError("VerifySchema", err)
+		}
+		var missing []string
+		for _, column := range columns {
+			if !existing[column] {
+				missing = append(missing, column)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("table %s is missing columns [%s], run the migrate command", table, strings.Join(missing, ", "))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fernandoalava/softwareengineer-test-task/authz"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// requireWorkspace returns the workspace every query of method must be restricted to.
+func requireWorkspace(ctx context.Context, method string) (int64, attribute.KeyValue, error) {
+	id, ok := workspace.FromContext(ctx)
+	if !ok {
+		return 0, attribute.KeyValue{}, fmt.Errorf("%s: %w", method, workspace.ErrMissing)
+	}
+	return id, attribute.Int64("scores.workspace_id", id), nil
+}
+
+// revieweeFilter returns the predicate on ratings r restricting a query to the reviewees set by
+// the authorization policy, and its arguments. Both are empty when ctx is unrestricted.
+func revieweeFilter(ctx context.Context) (string, []any) {
+	reviewees, ok := authz.Reviewees(ctx)
+	if !ok {
+		return "", nil
+	}
+	if len(reviewees) == 0 {
+		return " AND 0", nil
+	}
+	args := make([]any, len(reviewees))
+	for i, reviewee := range reviewees {
+		args[i] = reviewee
+	}
+	return " AND r.reviewee_id IN (?" + strings.Repeat(", ?", len(reviewees)-1) + ")", args
+}
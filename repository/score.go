@@ -3,12 +3,15 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"log/slog"
 	"time"
 
-	"log"
-
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
 	"github.com/fernandoalava/softwareengineer-test-task/domain"
+	"github.com/fernandoalava/softwareengineer-test-task/tracing"
 	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type ScoreRepository struct {
@@ -19,8 +22,16 @@ func NewScoreRepository(conn *sql.DB) *ScoreRepository {
 	return &ScoreRepository{conn}
 }
 
-func (repository *ScoreRepository) FetchScoreByTicketBetween(ctx context.Context, from time.Time, to time.Time) ([]domain.ScoreByTicket, error) {
-	query := `
+func (repository *ScoreRepository) FetchScoreByTicketBetween(ctx context.Context, from time.Time, to time.Time) (result []domain.ScoreByTicket, err error) {
+	workspaceID, workspaceAttribute, err := requireWorkspace(ctx, "ScoreRepository.FetchScoreByTicketBetween")
+	if err != nil {
+		return nil, err
+	}
+	ctx, done := observeQuery(ctx, "ScoreRepository.FetchScoreByTicketBetween", append(tracing.RangeAttributes(from, to), workspaceAttribute)...)
+	defer func() { done(len(result), err) }()
+
+	revieweePredicate, revieweeArgs := revieweeFilter(ctx)
+	query := fmt.Sprintf(`
 		WITH FilteredRatings AS (
 		SELECT
 			t.id as ticket_id,
@@ -31,11 +42,11 @@ func (repository *ScoreRepository) FetchScoreByTicketBetween(ctx context.Context
 		FROM
 			ratings r
 		JOIN
-			rating_categories c ON r.rating_category_id = c.id
+			rating_categories c ON r.rating_category_id = c.id AND c.workspace_id = r.workspace_id
 		JOIN
-			tickets t ON r.ticket_id = t.id
+			tickets t ON r.ticket_id = t.id AND t.workspace_id = r.workspace_id
 		WHERE
-			r.created_at BETWEEN ? AND ?
+			r.workspace_id = ? AND r.created_at >= ? AND r.created_at < ?%s
 	),
 	WeightedAverages AS (
 		SELECT
@@ -57,21 +68,21 @@ func (repository *ScoreRepository) FetchScoreByTicketBetween(ctx context.Context
 		COALESCE(ROUND(weighted_average / 5 * 100, 2),0) AS category_score
 	FROM
 		WeightedAverages;
-	`
-	rows, err := repository.Conn.QueryContext(ctx, query, util.TimeToString(from), util.TimeToString(to))
+	`, revieweePredicate)
+	args := append([]any{workspaceID, util.TimeToPreciseString(from), util.TimeToPreciseString(to)}, revieweeArgs...)
+	rows, err := queryerOf(ctx, repository.Conn).QueryContext(ctx, query, args...)
 	if err != nil {
-		log.Println("error while querying ratings table", err)
-		return nil, err
+		slog.ErrorContext(ctx, "error while querying ratings table", "error", err)
+		return nil, apperror.NewDatabaseError("ScoreRepository.FetchScoreByTicketBetween", err)
 	}
 
 	defer func() {
 		errRow := rows.Close()
 		if errRow != nil {
-			log.Println("error trying to close rows", err)
+			slog.ErrorContext(ctx, "error trying to close rows", "error", errRow)
 		}
 	}()
 
-	var result []domain.ScoreByTicket
 	for rows.Next() {
 		scoreByTicket := domain.ScoreByTicket{}
 		err = rows.Scan(
@@ -82,16 +93,28 @@ func (repository *ScoreRepository) FetchScoreByTicketBetween(ctx context.Context
 		)
 
 		if err != nil {
-			return nil, err
+			return nil, apperror.NewDatabaseError("ScoreRepository.FetchScoreByTicketBetween", err)
 		}
 		result = append(result, scoreByTicket)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, apperror.NewDatabaseError("ScoreRepository.FetchScoreByTicketBetween", err)
+	}
+
 	return result, nil
 }
 
-func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Context, from time.Time, to time.Time) ([]domain.ScoreByCategoryWithPeriod, error) {
-	query := `
+func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Context, from time.Time, to time.Time) (result []domain.ScoreByCategoryWithPeriod, err error) {
+	workspaceID, workspaceAttribute, err := requireWorkspace(ctx, "ScoreRepository.FetchAggregateScoreOverPeriod")
+	if err != nil {
+		return nil, err
+	}
+	ctx, done := observeQuery(ctx, "ScoreRepository.FetchAggregateScoreOverPeriod", append(tracing.RangeAttributes(from, to), workspaceAttribute)...)
+	defer func() { done(len(result), err) }()
+
+	revieweePredicate, revieweeArgs := revieweeFilter(ctx)
+	query := fmt.Sprintf(`
 		WITH FilteredRatings AS (
 		SELECT
 			t.id as ticket_id,
@@ -103,11 +126,11 @@ func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Con
 		FROM
 			ratings r
 		JOIN
-			rating_categories c ON r.rating_category_id = c.id
+			rating_categories c ON r.rating_category_id = c.id AND c.workspace_id = r.workspace_id
 		JOIN
-			tickets t ON r.ticket_id = t.id
+			tickets t ON r.ticket_id = t.id AND t.workspace_id = r.workspace_id
 		WHERE
-			r.created_at BETWEEN  ? AND ?
+			r.workspace_id = ? AND r.created_at >= ? AND r.created_at < ?%s
 	),
 	DailyAverages AS (
 		SELECT
@@ -115,7 +138,9 @@ func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Con
 			rating_category_name,
 			date(created_at) AS review_date,
 			SUM(rating * weight) / SUM(weight) AS daily_average_rating,
-			COUNT(*) AS daily_rating_count
+			COUNT(*) AS daily_rating_count,
+			SUM(rating * weight) AS daily_weighted_rating_sum,
+			SUM(weight) AS daily_weight_sum
 		FROM
 			FilteredRatings
 		GROUP BY
@@ -128,17 +153,19 @@ func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Con
 		SELECT
 			rating_category_id,
 			rating_category_name,
-			date(review_date, 'weekday 1') AS week_start,
-			date(review_date, 'weekday 1', '+6 days') AS week_end,
+			date(review_date, '-6 days', 'weekday 1') AS week_start,
+			date(review_date, '-6 days', 'weekday 1', '+7 days') AS week_end,
 			AVG(daily_average_rating) AS weekly_average_rating,
-			SUM(daily_rating_count) AS weekly_rating_count
+			SUM(daily_rating_count) AS weekly_rating_count,
+			SUM(daily_weighted_rating_sum) AS weekly_weighted_rating_sum,
+			SUM(daily_weight_sum) AS weekly_weight_sum
 		FROM
 			DailyAverages
 		GROUP BY
 			rating_category_id,
 			rating_category_name,
-			date(review_date, 'weekday 1'),
-			date(review_date, 'weekday 1', '+6 days') 
+			date(review_date, '-6 days', 'weekday 1'),
+			date(review_date, '-6 days', 'weekday 1', '+7 days')
 	)
 	,
 	AggregatedScores AS (
@@ -156,7 +183,15 @@ func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Con
 			CASE 
 				WHEN (julianday(?) - julianday(?)) <= 31 THEN daily_rating_count 
 				ELSE weekly_rating_count 
-			END AS rating_count
+			END AS rating_count,
+			CASE 
+				WHEN (julianday(?) - julianday(?)) <= 31 THEN daily_weighted_rating_sum 
+				ELSE weekly_weighted_rating_sum 
+			END AS weighted_rating_sum,
+			CASE 
+				WHEN (julianday(?) - julianday(?)) <= 31 THEN daily_weight_sum 
+				ELSE weekly_weight_sum 
+			END AS weight_sum
 		FROM
 			DailyAverages
 		LEFT JOIN
@@ -177,7 +212,15 @@ func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Con
 			CASE 
 				WHEN (julianday(?) - julianday(?)) <= 31 THEN daily_rating_count 
 				ELSE weekly_rating_count 
-			END AS rating_count
+			END AS rating_count,
+			CASE 
+				WHEN (julianday(?) - julianday(?)) <= 31 THEN daily_weighted_rating_sum 
+				ELSE weekly_weighted_rating_sum 
+			END AS weighted_rating_sum,
+			CASE 
+				WHEN (julianday(?) - julianday(?)) <= 31 THEN daily_weight_sum 
+				ELSE weekly_weight_sum 
+			END AS weight_sum
 		FROM
 			WeeklyAverages
 		LEFT JOIN
@@ -191,7 +234,9 @@ func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Con
 		rating_category_name,
 		aggregation_period,
 		ROUND(average_rating / 5 * 100, 2) AS category_score,
-		rating_count
+		rating_count,
+		weighted_rating_sum,
+		weight_sum
 	FROM
 		AggregatedScores
 	WHERE aggregation_period IS NOT NULL
@@ -199,22 +244,26 @@ func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Con
 		rating_category_id,
 		rating_category_name,
 		aggregation_period;
-	`
-	fromStringValue := util.TimeToString(from)
-	toStringValue := util.TimeToString(to)
-	rows, err := repository.Conn.QueryContext(ctx, query, fromStringValue, toStringValue, toStringValue, fromStringValue, toStringValue, fromStringValue, toStringValue, fromStringValue, toStringValue, fromStringValue, toStringValue, fromStringValue, toStringValue, fromStringValue)
+	`, revieweePredicate)
+	fromStringValue := util.TimeToPreciseString(from)
+	toStringValue := util.TimeToPreciseString(to)
+	args := append([]any{workspaceID, fromStringValue, toStringValue}, revieweeArgs...)
+	// Every CASE choosing between the daily and weekly columns compares the range length.
+	for range 10 {
+		args = append(args, toStringValue, fromStringValue)
+	}
+	rows, err := queryerOf(ctx, repository.Conn).QueryContext(ctx, query, args...)
 	if err != nil {
-		log.Println("error while querying ratings table", err)
-		return nil, err
+		slog.ErrorContext(ctx, "error while querying ratings table", "error", err)
+		return nil, apperror.NewDatabaseError("ScoreRepository.FetchAggregateScoreOverPeriod", err)
 	}
 
 	defer func() {
 		errRow := rows.Close()
 		if errRow != nil {
-			log.Println("error trying to close rows", err)
+			slog.ErrorContext(ctx, "error trying to close rows", "error", errRow)
 		}
 	}()
-	var result []domain.ScoreByCategoryWithPeriod
 	for rows.Next() {
 		scoreByCategoryWithPeriod := domain.ScoreByCategoryWithPeriod{}
 		var aggregatePeriod string
@@ -224,22 +273,40 @@ func (repository *ScoreRepository) FetchAggregateScoreOverPeriod(ctx context.Con
 			&aggregatePeriod,
 			&scoreByCategoryWithPeriod.CategoryScore,
 			&scoreByCategoryWithPeriod.RatingsCount,
+			&scoreByCategoryWithPeriod.WeightedRatingSum,
+			&scoreByCategoryWithPeriod.WeightSum,
 		)
+		if err != nil {
+			return nil, apperror.NewDatabaseError("ScoreRepository.FetchAggregateScoreOverPeriod", err)
+		}
 
 		period, err := util.ParsePeriodFromString(aggregatePeriod, "/")
 		if err != nil {
-			return nil, err
+			return nil, apperror.NewDatabaseError("ScoreRepository.FetchAggregateScoreOverPeriod", err)
 		}
 		scoreByCategoryWithPeriod.AggregationPeriod = *period
 		result = append(result, scoreByCategoryWithPeriod)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, apperror.NewDatabaseError("ScoreRepository.FetchAggregateScoreOverPeriod", err)
+	}
+
 	return result, nil
 }
 
-func (repository *ScoreRepository) FetchOverallQuality(ctx context.Context, from, to time.Time) (float64, error) {
+// FetchOverallQuality returns nil when [from, to) has no ratings.
+func (repository *ScoreRepository) FetchOverallQuality(ctx context.Context, from, to time.Time) (score *float64, err error) {
+	workspaceID, workspaceAttribute, err := requireWorkspace(ctx, "ScoreRepository.FetchOverallQuality")
+	if err != nil {
+		return nil, err
+	}
+	rowCount := 0
+	ctx, done := observeQuery(ctx, "ScoreRepository.FetchOverallQuality", append(tracing.RangeAttributes(from, to), workspaceAttribute)...)
+	defer func() { done(rowCount, err) }()
 
-	query := `
+	revieweePredicate, revieweeArgs := revieweeFilter(ctx)
+	query := fmt.Sprintf(`
 		WITH FilteredRatings AS (
 			SELECT
 				r.rating,
@@ -247,9 +314,9 @@ func (repository *ScoreRepository) FetchOverallQuality(ctx context.Context, from
 			FROM
 				ratings r
 			JOIN
-				rating_categories c ON r.rating_category_id = c.id
+				rating_categories c ON r.rating_category_id = c.id AND c.workspace_id = r.workspace_id
 			WHERE
-				r.created_at BETWEEN ? AND ?
+				r.workspace_id = ? AND r.created_at >= ? AND r.created_at < ?%s
 		),
 		WeightedAverage AS (
 			SELECT 
@@ -258,35 +325,110 @@ func (repository *ScoreRepository) FetchOverallQuality(ctx context.Context, from
 				FilteredRatings r
 		)
 		SELECT 
-			COALESCE(ROUND(AVG(overall_average_rating) / 5 * 100, 2), 0.0) AS overall_score 
+			ROUND(AVG(overall_average_rating) / 5 * 100, 2) AS overall_score 
 		FROM 
 			WeightedAverage;
-	`
+	`, revieweePredicate)
 
-	fromStringValue := util.TimeToString(from)
-	toStringValue := util.TimeToString(to)
-	rows, err := repository.Conn.QueryContext(ctx, query, fromStringValue, toStringValue)
+	fromStringValue := util.TimeToPreciseString(from)
+	toStringValue := util.TimeToPreciseString(to)
+	args := append([]any{workspaceID, fromStringValue, toStringValue}, revieweeArgs...)
+	rows, err := queryerOf(ctx, repository.Conn).QueryContext(ctx, query, args...)
 	if err != nil {
-		log.Println("error while querying ratings table", err)
-		return 0, err
+		slog.ErrorContext(ctx, "error while querying ratings table", "error", err)
+		return nil, apperror.NewDatabaseError("ScoreRepository.FetchOverallQuality", err)
 	}
 
 	defer func() {
 		errRow := rows.Close()
 		if errRow != nil {
-			log.Println("error trying to close rows", err)
+			slog.ErrorContext(ctx, "error trying to close rows", "error", errRow)
 		}
 	}()
-	var overallScore float32
+	var overallScore *float64
 	for rows.Next() {
 		err = rows.Scan(
 			&overallScore,
 		)
 		if err != nil {
-			return 0, err
+			return nil, apperror.NewDatabaseError("ScoreRepository.FetchOverallQuality", err)
 		}
+		rowCount++
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperror.NewDatabaseError("ScoreRepository.FetchOverallQuality", err)
 	}
 
-	return float64(overallScore), nil
+	return overallScore, nil
 
 }
+
+// FetchWorstTickets returns the limit tickets with the lowest score over their ratings in
+// [from, to), lowest first with ties broken by ticket ID. Tickets whose ratings all weigh 0 have
+// no score and are left out.
+func (repository *ScoreRepository) FetchWorstTickets(ctx context.Context, from, to time.Time, limit int) (result []domain.TicketScore, err error) {
+	workspaceID, workspaceAttribute, err := requireWorkspace(ctx, "ScoreRepository.FetchWorstTickets")
+	if err != nil {
+		return nil, err
+	}
+	ctx, done := observeQuery(ctx, "ScoreRepository.FetchWorstTickets", append(tracing.RangeAttributes(from, to), workspaceAttribute, attribute.Int("scores.limit", limit))...)
+	defer func() { done(len(result), err) }()
+
+	revieweePredicate, revieweeArgs := revieweeFilter(ctx)
+	query := fmt.Sprintf(`
+		SELECT
+			r.ticket_id,
+			ROUND(SUM(r.rating * c.weight) / SUM(c.weight) / 5 * 100, 2) AS ticket_score,
+			COUNT(*) AS rating_count
+		FROM
+			ratings r
+		JOIN
+			rating_categories c ON r.rating_category_id = c.id AND c.workspace_id = r.workspace_id
+		JOIN
+			tickets t ON r.ticket_id = t.id AND t.workspace_id = r.workspace_id
+		WHERE
+			r.workspace_id = ? AND r.created_at >= ? AND r.created_at < ?%s
+		GROUP BY
+			r.ticket_id
+		HAVING
+			SUM(c.weight) > 0
+		ORDER BY
+			ticket_score,
+			r.ticket_id
+		LIMIT ?;
+	`, revieweePredicate)
+	args := append([]any{workspaceID, util.TimeToPreciseString(from), util.TimeToPreciseString(to)}, revieweeArgs...)
+	args = append(args, limit)
+	rows, err := queryerOf(ctx, repository.Conn).QueryContext(ctx, query, args...)
+	if err != nil {
+		slog.ErrorContext(ctx, "error while querying ratings table", "error", err)
+		return nil, apperror.NewDatabaseError("ScoreRepository.FetchWorstTickets", err)
+	}
+
+	defer func() {
+		errRow := rows.Close()
+		if errRow != nil {
+			slog.ErrorContext(ctx, "error trying to close rows", "error", errRow)
+		}
+	}()
+
+	for rows.Next() {
+		ticketScore := domain.TicketScore{}
+		err = rows.Scan(
+			&ticketScore.TicketID,
+			&ticketScore.Score,
+			&ticketScore.RatingsCount,
+		)
+		if err != nil {
+			return nil, apperror.NewDatabaseError("ScoreRepository.FetchWorstTickets", err)
+		}
+		result = append(result, ticketScore)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, apperror.NewDatabaseError("ScoreRepository.FetchWorstTickets", err)
+	}
+
+	return result, nil
+}
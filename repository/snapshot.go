@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+)
+
+// Snapshots runs groups of queries in one read transaction, so they all see the ratings as they
+// were when the first of them ran, even while new ones are written.
+type Snapshots struct {
+	Conn *sql.DB
+}
+
+func NewSnapshots(conn *sql.DB) *Snapshots {
+	return &Snapshots{conn}
+}
+
+type snapshotKey struct{}
+
+// Run calls fn with a context whose repository queries all go through the same read
+// transaction, or through the one ctx already has. The queries of a snapshot share its
+// connection, so SQLite runs them one at a time even when fn runs them concurrently.
+func (snapshots *Snapshots) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(snapshotKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+	tx, err := snapshots.Conn.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		slog.ErrorContext(ctx, "error while starting a read transaction", "error", err)
+		return apperror.NewDatabaseError("Snapshots.Run", err)
+	}
+	defer func() {
+		errRollback := tx.Rollback()
+		if errRollback != nil && !errors.Is(errRollback, sql.ErrTxDone) {
+			slog.ErrorContext(ctx, "error trying to end a read transaction", "error", errRollback)
+		}
+	}()
+	return fn(context.WithValue(ctx, snapshotKey{}, tx))
+}
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// queryerOf returns the snapshot transaction of ctx, or conn outside of a snapshot.
+func queryerOf(ctx context.Context, conn *sql.DB) queryer {
+	if tx, ok := ctx.Value(snapshotKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return conn
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// SQLiteOptions tunes the connections opened by OpenSQLite. Zero values leave the SQLite
+// defaults in place.
+type SQLiteOptions struct {
+	// ReadOnly opens the file with mode=ro. The service only reads, so this is the default;
+	// once writes exist they should go through a separate single-connection pool opened with
+	// ReadOnly false, next to the read-only pool.
+	ReadOnly bool
+	// Immutable tells SQLite the file cannot change while it is open, skipping all locking.
+	// It requires ReadOnly.
+	Immutable bool
+	// JournalMode is applied with PRAGMA journal_mode, for example "wal". It is stored in the
+	// file, so it can only be changed by a writable connection.
+	JournalMode string
+	BusyTimeout time.Duration
+	// CacheSizeKiB is the page cache size of each connection.
+	CacheSizeKiB int
+	// MmapSize is the number of bytes of the file to memory map.
+	MmapSize int
+}
+
+// SQLiteDSN builds the modernc.org/sqlite DSN for the database file at path. Writable
+// connections use mode=rw, so neither mode creates a missing file.
+func SQLiteDSN(path string, options SQLiteOptions) string {
+	query := url.Values{}
+	if options.ReadOnly {
+		query.Set("mode", "ro")
+	} else {
+		query.Set("mode", "rw")
+	}
+	if options.Immutable {
+		query.Set("immutable", "1")
+	}
+	// busy_timeout goes first so it already applies to the pragmas after it.
+	if options.BusyTimeout > 0 {
+		query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", options.BusyTimeout.Milliseconds()))
+	}
+	if options.JournalMode != "" {
+		query.Add("_pragma", fmt.Sprintf("journal_mode(%s)", options.JournalMode))
+	}
+	if options.CacheSizeKiB > 0 {
+		query.Add("_pragma", fmt.Sprintf("cache_size(-%d)", options.CacheSizeKiB))
+	}
+	if options.MmapSize > 0 {
+		query.Add("_pragma", fmt.Sprintf("mmap_size(%d)", options.MmapSize))
+	}
+	escaper := strings.NewReplacer("%", "%25", "?", "%3f", "#", "%23")
+	return "file:" + escaper.Replace(path) + "?" + query.Encode()
+}
+
+// OpenSQLite checks that the database file at path exists and opens it with the given options.
+// A wrong path fails here instead of SQLite silently creating an empty database.
+func OpenSQLite(ctx context.Context, path string, options SQLiteOptions) (*sql.DB, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("database file: %w", err)
+	}
+	if !info.Mode().IsRegular() {
+		return nil, fmt.Errorf("database file %s is not a regular file", path)
+	}
+	db, err := sql.Open("sqlite", SQLiteDSN(path, options))
+	if err != nil {
+		return nil, err
+	}
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to open database %s: %w", path, err)
+	}
+	return db, nil
+}
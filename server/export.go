@@ -0,0 +1,123 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/report"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/samber/lo"
+)
+
+// ExportChunkSize is the largest amount of file data sent in one ExportChunk.
+var ExportChunkSize = 64 * 1024
+
+// reportNames name the sheet and the file of each report.
+var reportNames = map[pb.ReportType]string{
+	pb.ReportType_REPORT_TYPE_CATEGORIES_OVER_TIME: "categories-over-time",
+	pb.ReportType_REPORT_TYPE_TICKETS:              "tickets",
+	pb.ReportType_REPORT_TYPE_OVERALL:              "overall",
+}
+
+// ExportReport builds the report through the same ScoreService calls as the other RPCs, so an
+// export is authorized and rate limited like the RPC its report comes from.
+func (server *ScoreServer) ExportReport(request *pb.ExportReportRequest, stream pb.Scores_ExportReportServer) error {
+	ctx := stream.Context()
+	from, to, err := server.dateRange(&pb.DateRangeRequest{From: request.GetFrom(), To: request.GetTo()})
+	if err != nil {
+		return apperror.ToStatus(ctx, err)
+	}
+	name, ok := reportNames[request.GetReport()]
+	if !ok {
+		return apperror.ToStatus(ctx, apperror.NewValidationError("report", "must be one of REPORT_TYPE_CATEGORIES_OVER_TIME, REPORT_TYPE_TICKETS or REPORT_TYPE_OVERALL"))
+	}
+	format := request.GetFormat()
+	if format == pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED {
+		format = pb.ExportFormat_EXPORT_FORMAT_CSV
+	}
+	chunks := &chunkWriter{stream: stream, fileName: fmt.Sprintf("scores-%s_%s_%s", name, from.UTC().Format(time.DateOnly), to.UTC().Format(time.DateOnly))}
+	switch format {
+	case pb.ExportFormat_EXPORT_FORMAT_CSV:
+		chunks.fileName, chunks.contentType = chunks.fileName+".csv", report.CSVContentType
+	case pb.ExportFormat_EXPORT_FORMAT_XLSX:
+		chunks.fileName, chunks.contentType = chunks.fileName+".xlsx", report.XLSXContentType
+	default:
+		return apperror.ToStatus(ctx, apperror.NewValidationError("format", "must be EXPORT_FORMAT_CSV or EXPORT_FORMAT_XLSX"))
+	}
+	table, err := server.reportTable(ctx, request.GetReport(), from, to)
+	if err != nil {
+		return apperror.ToStatus(ctx, err)
+	}
+
+	writer := bufio.NewWriterSize(chunks, ExportChunkSize)
+	if format == pb.ExportFormat_EXPORT_FORMAT_XLSX {
+		err = table.WriteXLSX(writer, name)
+	} else {
+		err = table.WriteCSV(writer)
+	}
+	if err == nil {
+		err = writer.Flush()
+	}
+	if err != nil {
+		return apperror.ToStatus(ctx, err)
+	}
+	return nil
+}
+
+func (server *ScoreServer) reportTable(ctx context.Context, reportType pb.ReportType, from, to time.Time) (*report.Table, error) {
+	switch reportType {
+	case pb.ReportType_REPORT_TYPE_CATEGORIES_OVER_TIME:
+		result, err := server.scoreService.GetAggregatedCategoryScoresOverTime(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+		return report.CategoriesOverTime(lo.Map(result, func(category service.CategoryScoreOverTime, _ int) *pb.CategoryScoreOverTime {
+			return service.ToGrpcCategoryScoreOverTime(category)
+		})), nil
+	case pb.ReportType_REPORT_TYPE_TICKETS:
+		result, err := server.scoreService.GetScoreByTicket(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+		return report.Tickets(lo.Map(result, func(ticket service.TicketScoreByCategory, _ int) *pb.ScoreByTicket {
+			return service.ToGrpcScoreByTicket(ticket)
+		})), nil
+	default:
+		result, err := server.scoreService.GetOverAllQualityScore(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+		return report.Overall(from, to, service.ToGrpcOverAllQualityScore(result)), nil
+	}
+}
+
+// chunkWriter sends what is written to it as ExportChunks of at most ExportChunkSize bytes, the
+// first of which carries the file name and content type.
+type chunkWriter struct {
+	stream      pb.Scores_ExportReportServer
+	fileName    string
+	contentType string
+	sent        bool
+}
+
+func (writer *chunkWriter) Write(data []byte) (int, error) {
+	written := 0
+	for len(data) > 0 {
+		size := min(len(data), ExportChunkSize)
+		chunk := &pb.ExportChunk{Data: data[:size]}
+		if !writer.sent {
+			chunk.FileName, chunk.ContentType = writer.fileName, writer.contentType
+		}
+		if err := writer.stream.Send(chunk); err != nil {
+			return written, err
+		}
+		writer.sent = true
+		written += size
+		data = data[size:]
+	}
+	return written, nil
+}
@@ -0,0 +1,19 @@
+package server
+
+import (
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"google.golang.org/grpc"
+)
+
+// LegacyScoresServiceName is the name scores.v1.Scores had before it was moved to a versioned
+// package. Its messages and field numbers did not change, so clients generated from the old
+// grpc/scores.proto can keep calling it until they migrate.
+const LegacyScoresServiceName = "grpc.Scores"
+
+// RegisterLegacyScoresServer also serves srv as grpc.Scores. Reflection does not describe that
+// alias, since no registered file declares it.
+func RegisterLegacyScoresServer(registrar grpc.ServiceRegistrar, srv pb.ScoresServer) {
+	desc := pb.Scores_ServiceDesc
+	desc.ServiceName = LegacyScoresServiceName
+	registrar.RegisterService(&desc, srv)
+}
@@ -2,65 +2,128 @@ package server
 
 import (
 	"context"
+	"time"
 
-	pb "github.com/fernandoalava/softwareengineer-test-task/grpc"
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
 
 	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	_ "modernc.org/sqlite"
 )
 
+// ScoreService is implemented by service.ScoreService and by the ratelimit and authz
+// ScoreServices wrapping it, which main.go chains as ratelimit(authz(service)).
+type ScoreService interface {
+	GetScoreByTicket(ctx context.Context, from time.Time, to time.Time) ([]service.TicketScoreByCategory, error)
+	GetAggregatedCategoryScoresOverTime(ctx context.Context, from time.Time, to time.Time) ([]service.CategoryScoreOverTime, error)
+	GetOverAllQualityScore(ctx context.Context, from time.Time, to time.Time) (*float64, error)
+	GetPeriodOverPeriodScoreChange(ctx context.Context, from time.Time, to time.Time) (*service.GetPeriodOverPeriodScoreChangeResponse, error)
+	GetDashboard(ctx context.Context, from time.Time, to time.Time, sections service.DashboardSections) (*service.Dashboard, error)
+}
+
 type ScoreServer struct {
 	pb.UnimplementedScoresServer
-	scoreService *service.ScoreService
+	scoreService ScoreService
+	rangeLimits  util.RangeLimits
+}
+
+// timestampField returns nil only for an unset field, an explicit zero timestamp is rejected
+// rather than replaced by the default.
+func timestampField(field string, value *timestamppb.Timestamp) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if err := value.CheckValid(); err != nil {
+		return nil, apperror.NewValidationError(field, err.Error())
+	}
+	t := value.AsTime()
+	if t.IsZero() {
+		return nil, apperror.NewValidationError(field, zeroTimeMessage)
+	}
+	return &t, nil
+}
+
+const zeroTimeMessage = "must not be the zero time, leave it unset for the default"
+
+func (server *ScoreServer) dateRange(request *pb.DateRangeRequest) (time.Time, time.Time, error) {
+	return resolveDateRange(request.GetFrom(), request.GetTo(), server.rangeLimits)
+}
+
+func resolveDateRange(fromValue, toValue *timestamppb.Timestamp, rangeLimits util.RangeLimits) (time.Time, time.Time, error) {
+	from, err := timestampField("from", fromValue)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := timestampField("to", toValue)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return util.ResolveTimeRange(from, to, time.Now(), rangeLimits)
 }
 
 func (server *ScoreServer) GetScoreByTicket(request *pb.DateRangeRequest, stream pb.Scores_GetScoreByTicketServer) error {
-	result, err := server.scoreService.GetScoreByTicket(stream.Context(), request.From.AsTime(), request.To.AsTime())
+	ctx := stream.Context()
+	from, to, err := server.dateRange(request)
+	if err != nil {
+		return apperror.ToStatus(ctx, err)
+	}
+	result, err := server.scoreService.GetScoreByTicket(ctx, from, to)
 	if err != nil {
-		return err
+		return apperror.ToStatus(ctx, err)
 	}
 	for _, r := range result {
 		if err := stream.Send(service.ToGrpcScoreByTicket(r)); err != nil {
-			return err
+			return apperror.ToStatus(ctx, err)
 		}
 	}
 	return nil
 }
 
 func (server *ScoreServer) GetAggregatedCategoryScoresOverTime(request *pb.DateRangeRequest, stream pb.Scores_GetAggregatedCategoryScoresOverTimeServer) error {
-	result, err := server.scoreService.GetAggregatedCategoryScoresOverTime(stream.Context(), request.From.AsTime(), request.To.AsTime())
+	ctx := stream.Context()
+	from, to, err := server.dateRange(request)
 	if err != nil {
-		return err
+		return apperror.ToStatus(ctx, err)
+	}
+	result, err := server.scoreService.GetAggregatedCategoryScoresOverTime(ctx, from, to)
+	if err != nil {
+		return apperror.ToStatus(ctx, err)
 	}
 	for _, r := range result {
 		if err := stream.Send(service.ToGrpcCategoryScoreOverTime(r)); err != nil {
-			return err
+			return apperror.ToStatus(ctx, err)
 		}
 	}
 	return nil
 }
 
 func (server *ScoreServer) GetOverAllQualityScore(ctx context.Context, request *pb.DateRangeRequest) (*pb.OverAllQualityScoreResponse, error) {
-	result, err := server.scoreService.GetOverAllQualityScore(ctx, request.From.AsTime(), request.To.AsTime())
+	from, to, err := server.dateRange(request)
+	if err != nil {
+		return nil, apperror.ToStatus(ctx, err)
+	}
+	result, err := server.scoreService.GetOverAllQualityScore(ctx, from, to)
 	if err != nil {
-		return nil, err
+		return nil, apperror.ToStatus(ctx, err)
 	}
-	return &pb.OverAllQualityScoreResponse{OverAllScore: float32(result)}, nil
+	return service.ToGrpcOverAllQualityScore(result), nil
 }
 
 func (server *ScoreServer) GetPeriodOverPeriodScoreChange(ctx context.Context, request *pb.DateRangeRequest) (*pb.GetPeriodOverPeriodScoreChangeResponse, error) {
-	result, err := server.scoreService.GetPeriodOverPeriodScoreChange(ctx, request.From.AsTime(), request.To.AsTime())
+	from, to, err := server.dateRange(request)
+	if err != nil {
+		return nil, apperror.ToStatus(ctx, err)
+	}
+	result, err := server.scoreService.GetPeriodOverPeriodScoreChange(ctx, from, to)
 	if err != nil {
-		return nil, err
+		return nil, apperror.ToStatus(ctx, err)
 	}
-	return &pb.GetPeriodOverPeriodScoreChangeResponse{
-		CurrentPeriod:   service.ToGrpcPeriodScore(result.CurrentPeriod),
-		PreviousPeriod:  service.ToGrpcPeriodScore(result.PreviousPeriod),
-		ScoreDifference: float32(result.ScoreDifference),
-	}, nil
+	return service.ToGrpcPeriodOverPeriodScoreChange(result), nil
 }
 
-func NewScoreServer(scoreService *service.ScoreService) *ScoreServer {
-	server := &ScoreServer{scoreService: scoreService}
+func NewScoreServer(scoreService ScoreService, rangeLimits util.RangeLimits) *ScoreServer {
+	server := &ScoreServer{scoreService: scoreService, rangeLimits: rangeLimits}
 	return server
 }
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+)
+
+// ScoreServerV2 serves scores.v2.Scores from the same ScoreService as ScoreServer, so both
+// versions return the same scores, only with double precision on v2.
+type ScoreServerV2 struct {
+	pbv2.UnimplementedScoresServer
+	scoreService ScoreService
+	rangeLimits  util.RangeLimits
+}
+
+func (server *ScoreServerV2) dateRange(request *pbv2.DateRangeRequest) (time.Time, time.Time, error) {
+	return resolveDateRange(request.GetFrom(), request.GetTo(), server.rangeLimits)
+}
+
+func (server *ScoreServerV2) GetScoreByTicket(request *pbv2.DateRangeRequest, stream pbv2.Scores_GetScoreByTicketServer) error {
+	ctx := stream.Context()
+	from, to, err := server.dateRange(request)
+	if err != nil {
+		return apperror.ToStatus(ctx, err)
+	}
+	result, err := server.scoreService.GetScoreByTicket(ctx, from, to)
+	if err != nil {
+		return apperror.ToStatus(ctx, err)
+	}
+	for _, r := range result {
+		if err := stream.Send(service.ToGrpcV2ScoreByTicket(r)); err != nil {
+			return apperror.ToStatus(ctx, err)
+		}
+	}
+	return nil
+}
+
+func (server *ScoreServerV2) GetAggregatedCategoryScoresOverTime(request *pbv2.DateRangeRequest, stream pbv2.Scores_GetAggregatedCategoryScoresOverTimeServer) error {
+	ctx := stream.Context()
+	from, to, err := server.dateRange(request)
+	if err != nil {
+		return apperror.ToStatus(ctx, err)
+	}
+	result, err := server.scoreService.GetAggregatedCategoryScoresOverTime(ctx, from, to)
+	if err != nil {
+		return apperror.ToStatus(ctx, err)
+	}
+	for _, r := range result {
+		if err := stream.Send(service.ToGrpcV2CategoryScoreOverTime(r)); err != nil {
+			return apperror.ToStatus(ctx, err)
+		}
+	}
+	return nil
+}
+
+func (server *ScoreServerV2) GetOverAllQualityScore(ctx context.Context, request *pbv2.DateRangeRequest) (*pbv2.OverAllQualityScoreResponse, error) {
+	from, to, err := server.dateRange(request)
+	if err != nil {
+		return nil, apperror.ToStatus(ctx, err)
+	}
+	result, err := server.scoreService.GetOverAllQualityScore(ctx, from, to)
+	if err != nil {
+		return nil, apperror.ToStatus(ctx, err)
+	}
+	return service.ToGrpcV2OverAllQualityScore(result), nil
+}
+
+func (server *ScoreServerV2) GetPeriodOverPeriodScoreChange(ctx context.Context, request *pbv2.DateRangeRequest) (*pbv2.GetPeriodOverPeriodScoreChangeResponse, error) {
+	from, to, err := server.dateRange(request)
+	if err != nil {
+		return nil, apperror.ToStatus(ctx, err)
+	}
+	result, err := server.scoreService.GetPeriodOverPeriodScoreChange(ctx, from, to)
+	if err != nil {
+		return nil, apperror.ToStatus(ctx, err)
+	}
+	return service.ToGrpcV2PeriodOverPeriodScoreChange(result), nil
+}
+
+// DefaultWorstTickets is the number of worst tickets GetDashboard returns when the request leaves
+// it unset, MaxWorstTickets the most it returns.
+const (
+	DefaultWorstTickets = 10
+	MaxWorstTickets     = 100
+)
+
+func (server *ScoreServerV2) GetDashboard(ctx context.Context, request *pbv2.DashboardRequest) (*pbv2.DashboardResponse, error) {
+	from, to, err := resolveDateRange(request.GetFrom(), request.GetTo(), server.rangeLimits)
+	if err != nil {
+		return nil, apperror.ToStatus(ctx, err)
+	}
+	sections, err := dashboardSections(request)
+	if err != nil {
+		return nil, apperror.ToStatus(ctx, err)
+	}
+	result, err := server.scoreService.GetDashboard(ctx, from, to, sections)
+	if err != nil {
+		return nil, apperror.ToStatus(ctx, err)
+	}
+	return service.ToGrpcV2Dashboard(result, sections), nil
+}
+
+func dashboardSections(request *pbv2.DashboardRequest) (service.DashboardSections, error) {
+	var sections service.DashboardSections
+	if len(request.GetSections()) == 0 {
+		return sections, apperror.NewValidationError("sections", "must list at least one section")
+	}
+	limit := int(request.GetWorstTicketsLimit())
+	if limit < 0 || limit > MaxWorstTickets {
+		return sections, apperror.NewValidationError("worst_tickets_limit", fmt.Sprintf("must be between 0 and %d", MaxWorstTickets))
+	}
+	if limit == 0 {
+		limit = DefaultWorstTickets
+	}
+	for _, section := range request.GetSections() {
+		switch section {
+		case pbv2.DashboardSection_DASHBOARD_SECTION_OVERALL:
+			sections.Overall = true
+		case pbv2.DashboardSection_DASHBOARD_SECTION_CHANGE:
+			sections.Change = true
+		case pbv2.DashboardSection_DASHBOARD_SECTION_CATEGORIES_OVER_TIME:
+			sections.CategoriesOverTime = true
+		case pbv2.DashboardSection_DASHBOARD_SECTION_WORST_TICKETS:
+			sections.WorstTickets = limit
+		default:
+			return sections, apperror.NewValidationError("sections", "must only contain DASHBOARD_SECTION_OVERALL, DASHBOARD_SECTION_CHANGE, DASHBOARD_SECTION_CATEGORIES_OVER_TIME or DASHBOARD_SECTION_WORST_TICKETS")
+		}
+	}
+	return sections, nil
+}
+
+func NewScoreServerV2(scoreService ScoreService, rangeLimits util.RangeLimits) *ScoreServerV2 {
+	return &ScoreServerV2{scoreService: scoreService, rangeLimits: rangeLimits}
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+)
+
+// GracefulStop reports every service as NOT_SERVING so that no new traffic is routed to this
+// instance, then waits up to timeout for in-flight RPCs to finish before forcing the remaining
+// ones to stop. It returns false when the drain timed out.
+func GracefulStop(grpcServer *grpc.Server, healthServer *health.Server, timeout time.Duration) bool {
+	healthServer.Shutdown()
+
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-stopped:
+		return true
+	case <-timer.C:
+		grpcServer.Stop()
+		<-stopped
+		return false
+	}
+}
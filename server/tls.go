@@ -0,0 +1,165 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+// TLSFiles names the PEM files used to serve TLS. ClientCAFile is optional; when set, clients
+// must present a certificate signed by one of its CAs.
+type TLSFiles struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+	// NextProtos are the ALPN protocols offered, h2 when empty as gRPC requires.
+	NextProtos []string
+	// RetryInterval is the minimum time between two attempts to reload files that failed to
+	// load, DefaultTLSRetryInterval when zero.
+	RetryInterval time.Duration
+}
+
+// DefaultTLSRetryInterval spaces out the reloads of a broken rotation, which would otherwise
+// read and parse the files again on every handshake until they are fixed.
+const DefaultTLSRetryInterval = 10 * time.Second
+
+// NewTLSConfig returns a server TLS configuration that reloads the certificate, key and client
+// CA bundle when any of the files changes, so rotated certificates are picked up without a
+// restart. The files are loaded once here, so a broken setup fails at startup; later reload
+// failures are logged, the previous certificates stay in use and the files are retried at most
+// every RetryInterval.
+func NewTLSConfig(files TLSFiles) (*tls.Config, error) {
+	if files.RetryInterval == 0 {
+		files.RetryInterval = DefaultTLSRetryInterval
+	}
+	reloader := &tlsReloader{files: files}
+	if err := reloader.reload(); err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetConfigForClient: reloader.configForClient,
+	}, nil
+}
+
+type tlsReloader struct {
+	files TLSFiles
+
+	mutex         sync.Mutex
+	modTimes      []time.Time
+	certificate   *tls.Certificate
+	clientCAs     *x509.CertPool
+	reloadFailure error
+	attemptedAt   time.Time
+}
+
+// configForClient is called for every handshake and checks whether the files changed since
+// they were last loaded, unless the last attempt failed less than RetryInterval ago.
+func (reloader *tlsReloader) configForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	backingOff := reloader.reloadFailure != nil && time.Since(reloader.attemptedAt) < reloader.files.RetryInterval
+	if !backingOff {
+		reloader.reloadIfChanged()
+	}
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*reloader.certificate},
+		NextProtos:   []string{"h2"},
+	}
+	if len(reloader.files.NextProtos) > 0 {
+		config.NextProtos = reloader.files.NextProtos
+	}
+	if reloader.clientCAs != nil {
+		config.ClientCAs = reloader.clientCAs
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config, nil
+}
+
+// reloadIfChanged loads the files again when they changed since they were last loaded. It must
+// be called with the mutex held.
+func (reloader *tlsReloader) reloadIfChanged() {
+	modTimes, err := reloader.stat()
+	if err != nil || equalTimes(modTimes, reloader.modTimes) {
+		return
+	}
+	err = reloader.load(modTimes)
+	if err != nil && (reloader.reloadFailure == nil || reloader.reloadFailure.Error() != err.Error()) {
+		slog.Error("failed to reload TLS certificates, keeping the previous ones", "error", err, "retry_in", reloader.files.RetryInterval)
+	}
+	if err == nil {
+		slog.Info("reloaded TLS certificates", "cert_file", reloader.files.CertFile)
+	}
+	reloader.reloadFailure = err
+	reloader.attemptedAt = time.Now()
+}
+
+func (reloader *tlsReloader) reload() error {
+	reloader.mutex.Lock()
+	defer reloader.mutex.Unlock()
+
+	modTimes, err := reloader.stat()
+	if err != nil {
+		return err
+	}
+	return reloader.load(modTimes)
+}
+
+// load reads the files and only replaces the current certificates when all of them are valid.
+// It must be called with the mutex held.
+func (reloader *tlsReloader) load(modTimes []time.Time) error {
+	certificate, err := tls.LoadX509KeyPair(reloader.files.CertFile, reloader.files.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	var clientCAs *x509.CertPool
+	if reloader.files.ClientCAFile != "" {
+		pem, err := os.ReadFile(reloader.files.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to load TLS client CA: %w", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return errors.New("failed to load TLS client CA: no certificates found in " + reloader.files.ClientCAFile)
+		}
+	}
+	reloader.certificate = &certificate
+	reloader.clientCAs = clientCAs
+	reloader.modTimes = modTimes
+	return nil
+}
+
+func (reloader *tlsReloader) stat() ([]time.Time, error) {
+	var modTimes []time.Time
+	for _, path := range []string{reloader.files.CertFile, reloader.files.KeyFile, reloader.files.ClientCAFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS files: %w", err)
+		}
+		modTimes = append(modTimes, info.ModTime())
+	}
+	return modTimes, nil
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,53 +1,158 @@
 package service
 
 import (
-	"github.com/fernandoalava/softwareengineer-test-task/grpc"
+	scoresv1 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	scoresv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
 	"github.com/samber/lo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func ToGrpcRatingCategoryScore(ratingCategoryScore RatingCategoryScore) *grpc.RatingCategoryScore {
-	return &grpc.RatingCategoryScore{
+func ToGrpcRatingCategoryScore(ratingCategoryScore RatingCategoryScore) *scoresv1.RatingCategoryScore {
+	return &scoresv1.RatingCategoryScore{
 		RatingCategoryID:   int64(ratingCategoryScore.RatingCategoryID),
 		RatingCategoryName: ratingCategoryScore.RatingCategoryName,
 		Score:              float32(ratingCategoryScore.Score),
 	}
 }
 
-func ToGrpcScoreByTicket(ticketScoreByCategory TicketScoreByCategory) *grpc.ScoreByTicket {
-	return &grpc.ScoreByTicket{
+func ToGrpcScoreByTicket(ticketScoreByCategory TicketScoreByCategory) *scoresv1.ScoreByTicket {
+	return &scoresv1.ScoreByTicket{
 		TicketId: int64(ticketScoreByCategory.TicketID),
-		RatingCategoryScore: lo.Map(ticketScoreByCategory.RatingCategoryScores, func(ratingCategoryScore RatingCategoryScore, _ int) *grpc.RatingCategoryScore {
+		RatingCategoryScore: lo.Map(ticketScoreByCategory.RatingCategoryScores, func(ratingCategoryScore RatingCategoryScore, _ int) *scoresv1.RatingCategoryScore {
 			return ToGrpcRatingCategoryScore(ratingCategoryScore)
 		}),
 	}
 }
 
-func ToGrpPeriodScoreWithRatings(periodScoreWithRatings PeriodScoreWithRatings) *grpc.PeriodScoreWithRatings {
+func ToGrpPeriodScoreWithRatings(periodScoreWithRatings PeriodScoreWithRatings) *scoresv1.PeriodScoreWithRatings {
 
-	return &grpc.PeriodScoreWithRatings{
+	return &scoresv1.PeriodScoreWithRatings{
 		From:    timestamppb.New(periodScoreWithRatings.From),
 		To:      timestamppb.New(periodScoreWithRatings.To),
-		Score:   float32(periodScoreWithRatings.Score),
+		Score:   toGrpcScore(periodScoreWithRatings.Score),
 		Ratings: int32(periodScoreWithRatings.Ratings),
 	}
 }
 
-func ToGrpcCategoryScoreOverTime(categoryScoreOverTime CategoryScoreOverTime) *grpc.CategoryScoreOverTime {
-	return &grpc.CategoryScoreOverTime{
-		CategoryName: categoryScoreOverTime.CategoryName,
-		PeriodScoreWithRatings: lo.Map(categoryScoreOverTime.PeriodScoresWithRatings, func(periodScoreWithRatings PeriodScoreWithRatings, _ int) *grpc.PeriodScoreWithRatings {
+func ToGrpcCategoryScoreOverTime(categoryScoreOverTime CategoryScoreOverTime) *scoresv1.CategoryScoreOverTime {
+	return &scoresv1.CategoryScoreOverTime{
+		CategoryName:   categoryScoreOverTime.CategoryName,
+		CategoryID:     int64(categoryScoreOverTime.CategoryID),
+		CategoryWeight: categoryScoreOverTime.CategoryWeight,
+		PeriodScoreWithRatings: lo.Map(categoryScoreOverTime.PeriodScoresWithRatings, func(periodScoreWithRatings PeriodScoreWithRatings, _ int) *scoresv1.PeriodScoreWithRatings {
 			return ToGrpPeriodScoreWithRatings(periodScoreWithRatings)
 		}),
-		TotalScore:  float32(categoryScoreOverTime.TotalScore),
-		TotalRating: int32(categoryScoreOverTime.TotalRating),
+		TotalScore:      toGrpcScore(categoryScoreOverTime.TotalScore),
+		TotalRating:     int32(categoryScoreOverTime.TotalRating),
+		MeanPeriodScore: toGrpcScore(categoryScoreOverTime.MeanPeriodScore),
 	}
 }
 
-func ToGrpcPeriodScore(periodScore PeriodScore)*grpc.PeriodScore{
-	return &grpc.PeriodScore{
-		From: timestamppb.New(periodScore.From),
-		To: timestamppb.New(periodScore.To),
-		Score: float32(periodScore.Score),
+// ToGrpcPeriodScore sends 0 for periods without ratings, which v1 cannot leave unset.
+func ToGrpcPeriodScore(periodScore PeriodScore) *scoresv1.PeriodScore {
+	return &scoresv1.PeriodScore{
+		From:  timestamppb.New(periodScore.From),
+		To:    timestamppb.New(periodScore.To),
+		Score: float32(lo.FromPtr(periodScore.Score)),
 	}
-}
\ No newline at end of file
+}
+
+func ToGrpcOverAllQualityScore(score *float64) *scoresv1.OverAllQualityScoreResponse {
+	return &scoresv1.OverAllQualityScoreResponse{OverAllScore: toGrpcScore(score)}
+}
+
+func ToGrpcPeriodOverPeriodScoreChange(response *GetPeriodOverPeriodScoreChangeResponse) *scoresv1.GetPeriodOverPeriodScoreChangeResponse {
+	return &scoresv1.GetPeriodOverPeriodScoreChangeResponse{
+		CurrentPeriod:  ToGrpcPeriodScore(response.CurrentPeriod),
+		PreviousPeriod: ToGrpcPeriodScore(response.PreviousPeriod),
+		// v1 cannot leave it unset either, a change that cannot be computed is 0.
+		ScoreDifference: float32(lo.FromPtr(response.ScoreDifference)),
+	}
+}
+
+// toGrpcScore keeps a missing score unset instead of sending 0.
+func toGrpcScore(score *float64) *float32 {
+	if score == nil {
+		return nil
+	}
+	return lo.ToPtr(float32(*score))
+}
+
+// The ToGrpcV2 converters build the scores.v2 messages, which keep scores as float64.
+
+func ToGrpcV2ScoreByTicket(ticketScoreByCategory TicketScoreByCategory) *scoresv2.ScoreByTicket {
+	return &scoresv2.ScoreByTicket{
+		TicketId: int64(ticketScoreByCategory.TicketID),
+		RatingCategoryScores: lo.Map(ticketScoreByCategory.RatingCategoryScores, func(ratingCategoryScore RatingCategoryScore, _ int) *scoresv2.RatingCategoryScore {
+			return &scoresv2.RatingCategoryScore{
+				RatingCategoryId:   int64(ratingCategoryScore.RatingCategoryID),
+				RatingCategoryName: ratingCategoryScore.RatingCategoryName,
+				Score:              ratingCategoryScore.Score,
+			}
+		}),
+	}
+}
+
+func ToGrpcV2CategoryScoreOverTime(categoryScoreOverTime CategoryScoreOverTime) *scoresv2.CategoryScoreOverTime {
+	return &scoresv2.CategoryScoreOverTime{
+		CategoryName:   categoryScoreOverTime.CategoryName,
+		CategoryId:     int64(categoryScoreOverTime.CategoryID),
+		CategoryWeight: categoryScoreOverTime.CategoryWeight,
+		PeriodScoresWithRatings: lo.Map(categoryScoreOverTime.PeriodScoresWithRatings, func(periodScoreWithRatings PeriodScoreWithRatings, _ int) *scoresv2.PeriodScoreWithRatings {
+			return &scoresv2.PeriodScoreWithRatings{
+				From:    timestamppb.New(periodScoreWithRatings.From),
+				To:      timestamppb.New(periodScoreWithRatings.To),
+				Score:   periodScoreWithRatings.Score,
+				Ratings: int32(periodScoreWithRatings.Ratings),
+			}
+		}),
+		TotalScore:      categoryScoreOverTime.TotalScore,
+		TotalRating:     int32(categoryScoreOverTime.TotalRating),
+		MeanPeriodScore: categoryScoreOverTime.MeanPeriodScore,
+	}
+}
+
+func ToGrpcV2OverAllQualityScore(score *float64) *scoresv2.OverAllQualityScoreResponse {
+	return &scoresv2.OverAllQualityScoreResponse{OverallScore: score}
+}
+
+// ToGrpcV2Dashboard only sets the sections that were requested.
+func ToGrpcV2Dashboard(dashboard *Dashboard, sections DashboardSections) *scoresv2.DashboardResponse {
+	response := &scoresv2.DashboardResponse{}
+	if sections.Overall {
+		response.Overall = ToGrpcV2OverAllQualityScore(dashboard.OverallScore)
+	}
+	if sections.Change {
+		response.Change = ToGrpcV2PeriodOverPeriodScoreChange(dashboard.Change)
+	}
+	if sections.CategoriesOverTime {
+		response.CategoriesOverTime = lo.Map(dashboard.CategoriesOverTime, func(categoryScoreOverTime CategoryScoreOverTime, _ int) *scoresv2.CategoryScoreOverTime {
+			return ToGrpcV2CategoryScoreOverTime(categoryScoreOverTime)
+		})
+	}
+	if sections.WorstTickets > 0 {
+		response.WorstTickets = lo.Map(dashboard.WorstTickets, func(ticketScore TicketScore, _ int) *scoresv2.TicketScore {
+			return &scoresv2.TicketScore{
+				TicketId: int64(ticketScore.TicketID),
+				Score:    ticketScore.Score,
+				Ratings:  int32(ticketScore.Ratings),
+			}
+		})
+	}
+	return response
+}
+
+func ToGrpcV2PeriodOverPeriodScoreChange(response *GetPeriodOverPeriodScoreChangeResponse) *scoresv2.GetPeriodOverPeriodScoreChangeResponse {
+	toPeriodScore := func(periodScore PeriodScore) *scoresv2.PeriodScore {
+		return &scoresv2.PeriodScore{
+			From:  timestamppb.New(periodScore.From),
+			To:    timestamppb.New(periodScore.To),
+			Score: periodScore.Score,
+		}
+	}
+	return &scoresv2.GetPeriodOverPeriodScoreChangeResponse{
+		CurrentPeriod:   toPeriodScore(response.CurrentPeriod),
+		PreviousPeriod:  toPeriodScore(response.PreviousPeriod),
+		ScoreDifference: response.ScoreDifference,
+	}
+}
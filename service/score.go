@@ -1,12 +1,19 @@
 package service
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"log/slog"
+	"slices"
 	"time"
 
 	"github.com/fernandoalava/softwareengineer-test-task/domain"
+	"github.com/fernandoalava/softwareengineer-test-task/tracing"
 	"github.com/fernandoalava/softwareengineer-test-task/util"
 	"github.com/samber/lo"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/errgroup"
 )
 
 type RatingCategoryRepository interface {
@@ -16,25 +23,75 @@ type RatingCategoryRepository interface {
 type ScoreRepository interface {
 	FetchScoreByTicketBetween(ctx context.Context, from time.Time, to time.Time) (response []domain.ScoreByTicket, err error)
 	FetchAggregateScoreOverPeriod(ctx context.Context, from time.Time, to time.Time) ([]domain.ScoreByCategoryWithPeriod, error)
-	FetchOverallQuality(ctx context.Context, from, to time.Time) (float64, error)
+	FetchOverallQuality(ctx context.Context, from, to time.Time) (*float64, error)
+	FetchWorstTickets(ctx context.Context, from, to time.Time, limit int) ([]domain.TicketScore, error)
+}
+
+// Snapshotter runs fn with a context whose repository queries all read the same snapshot of the
+// database.
+type Snapshotter interface {
+	Run(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// maximumRating is the highest rating, scoring 100.
+const maximumRating = 5
+
+// CategoryOrder is the order of the categories returned by GetAggregatedCategoryScoresOverTime,
+// ties are broken by category ID.
+type CategoryOrder string
+
+const (
+	CategoryOrderID   CategoryOrder = "id"
+	CategoryOrderName CategoryOrder = "name"
+	// CategoryOrderWeight puts the heaviest categories first.
+	CategoryOrderWeight CategoryOrder = "weight"
+)
+
+func ParseCategoryOrder(value string) (CategoryOrder, error) {
+	switch order := CategoryOrder(value); order {
+	case CategoryOrderID, CategoryOrderName, CategoryOrderWeight:
+		return order, nil
+	}
+	return "", fmt.Errorf("invalid category order %q", value)
+}
+
+func (order CategoryOrder) compare(a, b domain.RatingCategory) int {
+	var byOrder int
+	switch order {
+	case CategoryOrderName:
+		byOrder = cmp.Compare(a.Name, b.Name)
+	case CategoryOrderWeight:
+		byOrder = cmp.Compare(b.Weight, a.Weight)
+	}
+	return cmp.Or(byOrder, cmp.Compare(a.ID, b.ID))
 }
 
 type ScoreService struct {
 	ratingCategoryRepository RatingCategoryRepository
 	scoreRepository          ScoreRepository
+	categoryOrder            CategoryOrder
+	snapshots                Snapshotter
 }
 
+// PeriodScoreWithRatings has a nil Score when the period has no ratings, or only ratings
+// weighing 0.
 type PeriodScoreWithRatings struct {
 	From    time.Time
 	To      time.Time
-	Score   float64
+	Score   *float64
 	Ratings uint32
 }
 
+// CategoryScoreOverTime has a TotalScore weighing every rating of the range alike, as
+// GetOverAllQualityScore does, and a MeanPeriodScore averaging the periods with a score. Both are
+// nil when no period has a score.
 type CategoryScoreOverTime struct {
+	CategoryID              uint64
 	CategoryName            string
+	CategoryWeight          float32
 	PeriodScoresWithRatings []PeriodScoreWithRatings
-	TotalScore              float64
+	TotalScore              *float64
+	MeanPeriodScore         *float64
 	TotalRating             uint32
 }
 
@@ -49,16 +106,62 @@ type TicketScoreByCategory struct {
 	RatingCategoryScores []RatingCategoryScore
 }
 
+// PeriodScore is the overall quality score of a period, nil when it has no ratings.
 type PeriodScore struct {
 	From  time.Time
 	To    time.Time
-	Score float64
+	Score *float64
 }
 
+// GetPeriodOverPeriodScoreChangeResponse has a nil ScoreDifference when the change cannot be
+// computed: either period has no ratings, or the previous one scored 0.
 type GetPeriodOverPeriodScoreChangeResponse struct {
 	CurrentPeriod   PeriodScore
 	PreviousPeriod  PeriodScore
-	ScoreDifference float64
+	ScoreDifference *float64
+}
+
+// TicketScore is the score of a ticket over all of its ratings of the range.
+type TicketScore struct {
+	TicketID uint64
+	Score    float64
+	Ratings  uint32
+}
+
+// DashboardSections selects what GetDashboard computes. WorstTickets is how many of the lowest
+// scoring tickets to return, none when 0.
+type DashboardSections struct {
+	Overall            bool
+	Change             bool
+	CategoriesOverTime bool
+	WorstTickets       int
+}
+
+// Methods returns the ScoreService methods computing the same data as the requested sections, so
+// a dashboard can be authorized and charged like the calls it replaces.
+func (sections DashboardSections) Methods() []string {
+	var methods []string
+	if sections.Overall {
+		methods = append(methods, "GetOverAllQualityScore")
+	}
+	if sections.Change {
+		methods = append(methods, "GetPeriodOverPeriodScoreChange")
+	}
+	if sections.CategoriesOverTime {
+		methods = append(methods, "GetAggregatedCategoryScoresOverTime")
+	}
+	if sections.WorstTickets > 0 {
+		methods = append(methods, "GetScoreByTicket")
+	}
+	return methods
+}
+
+// Dashboard holds the sections requested from GetDashboard, the others are left empty.
+type Dashboard struct {
+	OverallScore       *float64
+	Change             *GetPeriodOverPeriodScoreChangeResponse
+	CategoriesOverTime []CategoryScoreOverTime
+	WorstTickets       []TicketScore
 }
 
 type RatingCategoryPeriodScore struct {
@@ -72,12 +175,29 @@ func NewScoreService(ratingCategoryRepository RatingCategoryRepository, scoreRep
 	return &ScoreService{
 		ratingCategoryRepository: ratingCategoryRepository,
 		scoreRepository:          scoreRepository,
+		categoryOrder:            CategoryOrderID,
 	}
 }
 
-func (scoreService *ScoreService) GetScoreByTicket(ctx context.Context, from time.Time, to time.Time) ([]TicketScoreByCategory, error) {
+// WithCategoryOrder sets the order of the categories returned by
+// GetAggregatedCategoryScoresOverTime, CategoryOrderID by default.
+func (scoreService *ScoreService) WithCategoryOrder(order CategoryOrder) *ScoreService {
+	scoreService.categoryOrder = order
+	return scoreService
+}
+
+// WithSnapshots makes GetDashboard compute all of its sections in one snapshot. Without it, each
+// query reads the database as it is when it runs.
+func (scoreService *ScoreService) WithSnapshots(snapshots Snapshotter) *ScoreService {
+	scoreService.snapshots = snapshots
+	return scoreService
+}
 
-	err := util.ValidateTimeRange(from, to)
+func (scoreService *ScoreService) GetScoreByTicket(ctx context.Context, from time.Time, to time.Time) (_ []TicketScoreByCategory, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "ScoreService.GetScoreByTicket", trace.WithAttributes(tracing.RangeAttributes(from, to)...))
+	defer func() { tracing.End(span, err) }()
+
+	err = util.ValidateTimeRange(from, to)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +207,10 @@ func (scoreService *ScoreService) GetScoreByTicket(ctx context.Context, from tim
 		return nil, err
 	}
 
+	_, groupSpan := tracing.Tracer().Start(ctx, "ScoreService.groupByTicket")
+	defer groupSpan.End()
+	slog.DebugContext(ctx, "grouping scores by ticket", "rows", len(categoryScoresByTicket))
+
 	return lo.MapToSlice(lo.GroupBy(categoryScoresByTicket, func(scoreByTicket domain.ScoreByTicket) uint64 {
 		return scoreByTicket.TicketID
 	}), func(ticketId uint64, scoresByTicket []domain.ScoreByTicket) TicketScoreByCategory {
@@ -104,8 +228,11 @@ func (scoreService *ScoreService) GetScoreByTicket(ctx context.Context, from tim
 
 }
 
-func (scoreService *ScoreService) GetAggregatedCategoryScoresOverTime(ctx context.Context, from time.Time, to time.Time) ([]CategoryScoreOverTime, error) {
-	err := util.ValidateTimeRange(from, to)
+func (scoreService *ScoreService) GetAggregatedCategoryScoresOverTime(ctx context.Context, from time.Time, to time.Time) (_ []CategoryScoreOverTime, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "ScoreService.GetAggregatedCategoryScoresOverTime", trace.WithAttributes(tracing.RangeAttributes(from, to)...))
+	defer func() { tracing.End(span, err) }()
+
+	err = util.ValidateTimeRange(from, to)
 	if err != nil {
 		return nil, err
 	}
@@ -123,21 +250,29 @@ func (scoreService *ScoreService) GetAggregatedCategoryScoresOverTime(ctx contex
 		return nil, err
 	}
 
-	aggregateScoreOverPeriodGroupedByCategory := lo.MapValues(lo.GroupBy(aggregateScoreOverPeriod, func(score domain.ScoreByCategoryWithPeriod) string { return score.CategoryName }), func(scores []domain.ScoreByCategoryWithPeriod, _ string) map[util.DateRange][]domain.ScoreByCategoryWithPeriod {
+	_, groupSpan := tracing.Tracer().Start(ctx, "ScoreService.groupByCategoryAndPeriod")
+	defer groupSpan.End()
+	slog.DebugContext(ctx, "grouping scores by category and period", "rows", len(aggregateScoreOverPeriod), "categories", len(categories), "periods", len(rangeOfDates))
+
+	aggregateScoreOverPeriodByCategory := lo.GroupBy(aggregateScoreOverPeriod, func(score domain.ScoreByCategoryWithPeriod) uint64 { return score.CategoryID })
+	aggregateScoreOverPeriodGroupedByCategory := lo.MapValues(aggregateScoreOverPeriodByCategory, func(scores []domain.ScoreByCategoryWithPeriod, _ uint64) map[util.DateRange][]domain.ScoreByCategoryWithPeriod {
 		return lo.GroupBy(scores, func(score domain.ScoreByCategoryWithPeriod) util.DateRange {
 			return score.AggregationPeriod
 		})
 	})
 
+	// The categories may be shared with a cache, so they are sorted in a copy.
+	categories = slices.SortedStableFunc(slices.Values(categories), scoreService.categoryOrder.compare)
+
 	return lo.Map(categories, func(category domain.RatingCategory, _ int) CategoryScoreOverTime {
-		groupedByRange, exists := aggregateScoreOverPeriodGroupedByCategory[category.Name]
+		groupedByRange, exists := aggregateScoreOverPeriodGroupedByCategory[category.ID]
 		scoresWithRating := lo.FlatMap(rangeOfDates, func(currentRange util.DateRange, _ int) []PeriodScoreWithRatings {
 			existingScoreInRange, exists := groupedByRange[currentRange]
 			if !exists {
 				return []PeriodScoreWithRatings{{
 					From:    currentRange.From,
 					To:      currentRange.To,
-					Score:   float64(0),
+					Score:   nil,
 					Ratings: uint32(0),
 				}}
 			}
@@ -145,7 +280,7 @@ func (scoreService *ScoreService) GetAggregatedCategoryScoresOverTime(ctx contex
 				return PeriodScoreWithRatings{
 					From:    score.AggregationPeriod.From,
 					To:      score.AggregationPeriod.To,
-					Score:   util.FormatScore(score.CategoryScore),
+					Score:   formatOptionalScore(score.CategoryScore),
 					Ratings: uint32(score.RatingsCount),
 				}
 
@@ -153,66 +288,174 @@ func (scoreService *ScoreService) GetAggregatedCategoryScoresOverTime(ctx contex
 		})
 		if !exists {
 			return CategoryScoreOverTime{
+				CategoryID:              category.ID,
 				CategoryName:            category.Name,
+				CategoryWeight:          category.Weight,
 				PeriodScoresWithRatings: scoresWithRating,
 				TotalRating:             0,
-				TotalScore:              0,
+				TotalScore:              nil,
 			}
 		}
 		totalRating := lo.SumBy(scoresWithRating, func(period PeriodScoreWithRatings) uint32 {
 			return period.Ratings
 		})
-		totalScore := lo.SumBy(scoresWithRating, func(period PeriodScoreWithRatings) float64 {
-			return period.Score
-		}) / float64(len(scoresWithRating))
+		scored := lo.Filter(scoresWithRating, func(period PeriodScoreWithRatings, _ int) bool {
+			return period.Score != nil
+		})
+		var meanPeriodScore *float64
+		if len(scored) > 0 {
+			meanPeriodScore = lo.ToPtr(util.FormatScore(lo.SumBy(scored, func(period PeriodScoreWithRatings) float64 {
+				return *period.Score
+			}) / float64(len(scored))))
+		}
 		return CategoryScoreOverTime{
+			CategoryID:              category.ID,
 			CategoryName:            category.Name,
+			CategoryWeight:          category.Weight,
 			PeriodScoresWithRatings: scoresWithRating,
 			TotalRating:             totalRating,
-			TotalScore:              util.FormatScore(totalScore),
+			TotalScore:              weightedTotalScore(aggregateScoreOverPeriodByCategory[category.ID]),
+			MeanPeriodScore:         meanPeriodScore,
 		}
 	}), nil
 
 }
 
-func (scoreService *ScoreService) GetOverAllQualityScore(ctx context.Context, from time.Time, to time.Time) (float64, error) {
-	err := util.ValidateTimeRange(from, to)
+// GetOverAllQualityScore returns nil when [from, to) has no ratings.
+func (scoreService *ScoreService) GetOverAllQualityScore(ctx context.Context, from time.Time, to time.Time) (_ *float64, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "ScoreService.GetOverAllQualityScore", trace.WithAttributes(tracing.RangeAttributes(from, to)...))
+	defer func() { tracing.End(span, err) }()
+
+	err = util.ValidateTimeRange(from, to)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
 	score, err := scoreService.scoreRepository.FetchOverallQuality(ctx, from, to)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	return formatOptionalScore(score), nil
+}
+
+// weightedTotalScore adds up the weighted sums of the periods of a category, nil when its ratings
+// all weigh 0.
+func weightedTotalScore(periods []domain.ScoreByCategoryWithPeriod) *float64 {
+	weightSum := lo.SumBy(periods, func(period domain.ScoreByCategoryWithPeriod) float64 {
+		return period.WeightSum
+	})
+	if weightSum == 0 {
+		return nil
 	}
+	weightedRatingSum := lo.SumBy(periods, func(period domain.ScoreByCategoryWithPeriod) float64 {
+		return period.WeightedRatingSum
+	})
+	return lo.ToPtr(util.FormatScore(weightedRatingSum / weightSum / maximumRating * 100))
+}
 
-	return util.FormatScore(score), nil
+func formatOptionalScore(score *float64) *float64 {
+	if score == nil {
+		return nil
+	}
+	return lo.ToPtr(util.FormatScore(*score))
 }
 
-func (scoreService *ScoreService) GetPeriodOverPeriodScoreChange(ctx context.Context, from time.Time, to time.Time) (*GetPeriodOverPeriodScoreChangeResponse, error) {
-	err := util.ValidateTimeRange(from, to)
+func (scoreService *ScoreService) GetPeriodOverPeriodScoreChange(ctx context.Context, from time.Time, to time.Time) (_ *GetPeriodOverPeriodScoreChangeResponse, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "ScoreService.GetPeriodOverPeriodScoreChange", trace.WithAttributes(tracing.RangeAttributes(from, to)...))
+	defer func() { tracing.End(span, err) }()
+
+	err = util.ValidateTimeRange(from, to)
 	if err != nil {
 		return nil, err
 	}
 
 	previousFrom, previousTo := util.CalculatePreviousPeriod(from, to)
 
-	overAllQualityScoreCurrentPeriod, err := scoreService.GetOverAllQualityScore(ctx, from, to)
+	currentScore, err := scoreService.GetOverAllQualityScore(ctx, from, to)
 	if err != nil {
 		return nil, err
 	}
-	overAllQualityScorePreviousPeriod, err := scoreService.GetOverAllQualityScore(ctx, previousFrom, previousTo)
+	previousScore, err := scoreService.GetOverAllQualityScore(ctx, previousFrom, previousTo)
 	if err != nil {
 		return nil, err
 	}
-	periodScoreCurrentPeriod := PeriodScore{From: from, To: to, Score: overAllQualityScoreCurrentPeriod}
-	periodScorePreviousPeriod := PeriodScore{From: from, To: to, Score: overAllQualityScorePreviousPeriod}
 	getPeriodOverPeriodScoreChangeResponse := &GetPeriodOverPeriodScoreChangeResponse{
-		CurrentPeriod:   periodScoreCurrentPeriod,
-		PreviousPeriod:  periodScorePreviousPeriod,
-		ScoreDifference: util.FormatScore((overAllQualityScoreCurrentPeriod - overAllQualityScorePreviousPeriod) / overAllQualityScorePreviousPeriod),
+		CurrentPeriod:  PeriodScore{From: from, To: to, Score: currentScore},
+		PreviousPeriod: PeriodScore{From: previousFrom, To: previousTo, Score: previousScore},
+	}
+	// Dividing by a missing or 0 previous score would give NaN or ±Inf.
+	if currentScore != nil && previousScore != nil && *previousScore != 0 {
+		getPeriodOverPeriodScoreChangeResponse.ScoreDifference = lo.ToPtr(util.FormatScore((*currentScore - *previousScore) / *previousScore))
 	}
 
 	return getPeriodOverPeriodScoreChangeResponse, nil
 
 }
+
+// GetDashboard computes the requested sections concurrently, in one snapshot of the database so
+// they all count the same ratings.
+func (scoreService *ScoreService) GetDashboard(ctx context.Context, from time.Time, to time.Time, sections DashboardSections) (_ *Dashboard, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "ScoreService.GetDashboard", trace.WithAttributes(tracing.RangeAttributes(from, to)...))
+	defer func() { tracing.End(span, err) }()
+
+	err = util.ValidateTimeRange(from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboard := &Dashboard{}
+	err = scoreService.inSnapshot(ctx, func(ctx context.Context) error {
+		group, ctx := errgroup.WithContext(ctx)
+		if sections.Overall {
+			group.Go(func() (err error) {
+				dashboard.OverallScore, err = scoreService.GetOverAllQualityScore(ctx, from, to)
+				return err
+			})
+		}
+		if sections.Change {
+			group.Go(func() (err error) {
+				dashboard.Change, err = scoreService.GetPeriodOverPeriodScoreChange(ctx, from, to)
+				return err
+			})
+		}
+		if sections.CategoriesOverTime {
+			group.Go(func() (err error) {
+				dashboard.CategoriesOverTime, err = scoreService.GetAggregatedCategoryScoresOverTime(ctx, from, to)
+				return err
+			})
+		}
+		if sections.WorstTickets > 0 {
+			group.Go(func() (err error) {
+				dashboard.WorstTickets, err = scoreService.getWorstTickets(ctx, from, to, sections.WorstTickets)
+				return err
+			})
+		}
+		return group.Wait()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dashboard, nil
+}
+
+func (scoreService *ScoreService) inSnapshot(ctx context.Context, fn func(ctx context.Context) error) error {
+	if scoreService.snapshots == nil {
+		return fn(ctx)
+	}
+	return scoreService.snapshots.Run(ctx, fn)
+}
+
+func (scoreService *ScoreService) getWorstTickets(ctx context.Context, from time.Time, to time.Time, limit int) ([]TicketScore, error) {
+	ticketScores, err := scoreService.scoreRepository.FetchWorstTickets(ctx, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(ticketScores, func(ticketScore domain.TicketScore, _ int) TicketScore {
+		return TicketScore{
+			TicketID: ticketScore.TicketID,
+			Score:    util.FormatScore(ticketScore.Score),
+			Ratings:  uint32(ticketScore.RatingsCount),
+		}
+	}), nil
+}
@@ -0,0 +1,61 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestToStatusMapsErrorsToCodes(t *testing.T) {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{apperror.NewValidationError("from", "must be set"), codes.InvalidArgument},
+		{fmt.Errorf("lookup: %w", apperror.ErrNotFound), codes.NotFound},
+		{apperror.NewDatabaseError("query", sql.ErrNoRows), codes.NotFound},
+		{apperror.NewDatabaseError("query", context.Canceled), codes.Canceled},
+		{apperror.NewDatabaseError("query", context.DeadlineExceeded), codes.DeadlineExceeded},
+		{apperror.NewDatabaseError("query", errors.New("SQL logic error: no such table: ratings")), codes.Internal},
+		{status.Error(codes.Unavailable, "unavailable"), codes.Unavailable},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.code, status.Code(apperror.ToStatus(context.TODO(), c.err)), c.err.Error())
+	}
+	assert.Nil(t, apperror.ToStatus(context.TODO(), nil))
+}
+
+func TestToStatusDoesNotLeakDatabaseErrors(t *testing.T) {
+	err := apperror.ToStatus(context.TODO(), apperror.NewDatabaseError("query", errors.New("SQL logic error: no such table: ratings")))
+
+	assert.NotContains(t, status.Convert(err).Message(), "SQL")
+}
+
+func TestGrpcInvalidRangeReturnsBadRequest(t *testing.T) {
+	client, closer := grpcServer()
+	defer closer()
+
+	from, _ := util.StringToTime("2019-07-17T23:59:00")
+	to, _ := util.StringToTime("2019-07-17T00:00:00")
+
+	_, err := client.GetOverAllQualityScore(context.TODO(), &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)})
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	assert.Len(t, st.Details(), 1)
+	badRequest, ok := st.Details()[0].(*errdetails.BadRequest)
+	assert.True(t, ok)
+	assert.Equal(t, "from", badRequest.GetFieldViolations()[0].GetField())
+}
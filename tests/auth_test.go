@@ -0,0 +1,190 @@
+package tests
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const testHMACSecret = "0123456789abcdef0123456789abcdef"
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func apiKeyHash(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func apiKeysFile(t *testing.T, subject, key string) string {
+	return writeFile(t, "api_keys.yaml", fmt.Sprintf("api_keys:\n  - subject: %s\n    workspace_id: 1\n    sha256: %s\n", subject, apiKeyHash(key)))
+}
+
+func hmacToken(t *testing.T, claims jwt.MapClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testHMACSecret))
+	assert.Nil(t, err)
+	return token
+}
+
+func withCredentials(key, value string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(key, value))
+}
+
+func testAuthenticator(t *testing.T) *auth.Authenticator {
+	authenticator, err := auth.NewAuthenticator(auth.Options{
+		HMACSecretFile: writeFile(t, "secret", testHMACSecret+"\n"),
+		Issuer:         "https://issuer.example",
+		APIKeysFile:    apiKeysFile(t, "reporting", "key-1"),
+		ExemptMethods:  []string{"/grpc.health.v1.Health/"},
+	})
+	assert.Nil(t, err)
+	return authenticator
+}
+
+func TestAuthenticateHMACToken(t *testing.T) {
+	authenticator := testAuthenticator(t)
+	token := hmacToken(t, jwt.MapClaims{"sub": "agent-1", "iss": "https://issuer.example", "workspace_id": 1, "exp": time.Now().Add(time.Hour).Unix()})
+
+	identity, err := authenticator.Authenticate(withCredentials("authorization", "Bearer "+token))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "agent-1", identity.Subject)
+	assert.Equal(t, auth.MethodJWT, identity.Method)
+}
+
+func TestAuthenticateRejectsInvalidTokens(t *testing.T) {
+	authenticator := testAuthenticator(t)
+	expiry := time.Now().Add(time.Hour).Unix()
+	tokens := map[string]string{
+		"expired":       hmacToken(t, jwt.MapClaims{"sub": "agent-1", "iss": "https://issuer.example", "workspace_id": 1, "exp": time.Now().Add(-time.Hour).Unix()}),
+		"no expiry":     hmacToken(t, jwt.MapClaims{"sub": "agent-1", "iss": "https://issuer.example", "workspace_id": 1}),
+		"wrong issuer":  hmacToken(t, jwt.MapClaims{"sub": "agent-1", "iss": "https://other.example", "workspace_id": 1, "exp": expiry}),
+		"no subject":    hmacToken(t, jwt.MapClaims{"iss": "https://issuer.example", "workspace_id": 1, "exp": expiry}),
+		"no workspace":  hmacToken(t, jwt.MapClaims{"sub": "agent-1", "iss": "https://issuer.example", "exp": expiry}),
+		"bad workspace": hmacToken(t, jwt.MapClaims{"sub": "agent-1", "iss": "https://issuer.example", "workspace_id": "acme", "exp": expiry}),
+		"unsigned":      "eyJhbGciOiJub25lIn0.eyJzdWIiOiJhZ2VudC0xIn0.",
+	}
+	wrongSecret, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "agent-1", "iss": "https://issuer.example", "workspace_id": 1, "exp": expiry}).SignedString([]byte("another secret of at least 32 bytes"))
+	assert.Nil(t, err)
+	tokens["wrong secret"] = wrongSecret
+
+	for name, token := range tokens {
+		_, err := authenticator.Authenticate(withCredentials("authorization", "Bearer "+token))
+		assert.ErrorIs(t, err, auth.ErrInvalidCredentials, name)
+	}
+
+	_, err = authenticator.Authenticate(withCredentials("authorization", "Basic dXNlcjpwYXNz"))
+	assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+	_, err = authenticator.Authenticate(context.Background())
+	assert.ErrorIs(t, err, auth.ErrMissingCredentials)
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	authenticator := testAuthenticator(t)
+
+	identity, err := authenticator.Authenticate(withCredentials("x-api-key", "key-1"))
+	assert.Nil(t, err)
+	assert.Equal(t, "reporting", identity.Subject)
+	assert.Equal(t, auth.MethodAPIKey, identity.Method)
+
+	_, err = authenticator.Authenticate(withCredentials("x-api-key", "key-2"))
+	assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+}
+
+func TestAuthenticateJWKSToken(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	encode := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"EC","crv":"P-256","kid":"k1","alg":"ES256","use":"sig","x":%q,"y":%q}]}`,
+		encode(key.X.FillBytes(make([]byte, 32))), encode(key.Y.FillBytes(make([]byte, 32))))
+	authenticator, err := auth.NewAuthenticator(auth.Options{JWKSFile: writeFile(t, "jwks.json", jwks), Audience: "scores"})
+	assert.Nil(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{"sub": "lead-1", "workspace_id": "2", "aud": "scores", "exp": time.Now().Add(time.Hour).Unix()})
+	token.Header["kid"] = "k1"
+	signed, err := token.SignedString(key)
+	assert.Nil(t, err)
+
+	identity, err := authenticator.Authenticate(withCredentials("authorization", "Bearer "+signed))
+	assert.Nil(t, err)
+	assert.Equal(t, "lead-1", identity.Subject)
+	assert.Equal(t, int64(2), identity.WorkspaceID)
+
+	// An HMAC token must not be accepted when only a JWKS is configured.
+	_, err = authenticator.Authenticate(withCredentials("authorization", "Bearer "+hmacToken(t, jwt.MapClaims{"sub": "x", "aud": "scores", "exp": time.Now().Add(time.Hour).Unix()})))
+	assert.ErrorIs(t, err, auth.ErrInvalidCredentials)
+}
+
+func TestAuthInterceptorExemptsMethods(t *testing.T) {
+	interceptor := auth.UnaryServerInterceptor(testAuthenticator(t))
+	var identity auth.Identity
+	var authenticated bool
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		identity, authenticated = auth.FromContext(ctx)
+		return nil, nil
+	}
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/grpc.health.v1.Health/Check"}, handler)
+	assert.Nil(t, err)
+	assert.False(t, authenticated)
+
+	_, err = interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/scores.v1.Scores/GetOverAllQualityScore"}, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = interceptor(withCredentials("x-api-key", "key-1"), nil, &grpc.UnaryServerInfo{FullMethod: "/scores.v1.Scores/GetOverAllQualityScore"}, handler)
+	assert.Nil(t, err)
+	assert.True(t, authenticated)
+	assert.Equal(t, "reporting", identity.Subject)
+}
+
+func TestGrpcRequiresAuthentication(t *testing.T) {
+	authenticator := testAuthenticator(t)
+	client, closer := grpcServerWithRangeLimits(util.DefaultRangeLimits,
+		grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(authenticator)),
+		grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(authenticator)),
+	)
+	defer closer()
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-18T00:00:00")
+	request := &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)}
+
+	_, err := client.GetOverAllQualityScore(context.Background(), request)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	stream, err := client.GetScoreByTicket(context.Background(), request)
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "key-1")
+	_, err = client.GetOverAllQualityScore(ctx, request)
+	assert.Nil(t, err)
+
+	token := hmacToken(t, jwt.MapClaims{"sub": "agent-1", "iss": "https://issuer.example", "workspace_id": 1, "exp": time.Now().Add(time.Hour).Unix()})
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+token)
+	_, err = client.GetOverAllQualityScore(ctx, request)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	"github.com/fernandoalava/softwareengineer-test-task/authz"
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testPolicy = `roles:
+  agent:
+    scope: self
+    methods: [GetScoreByTicket, GetOverAllQualityScore]
+  team_lead:
+    scope: team
+    methods: ["*"]
+  admin:
+    scope: all
+    methods: ["*"]
+teams:
+  - name: support
+    workspace_id: 1
+    leads: [10]
+    members: [11, 12]
+  - name: other workspace
+    workspace_id: 2
+    leads: [10]
+    members: [20]
+`
+
+// revieweeDatabase rates one ticket per reviewee in workspace 1: 11 gets 5, 12 gets 1 and 20,
+// who is in no team of that workspace, gets 4.
+func revieweeDatabase(t *testing.T) *sql.DB {
+	return seededDatabase(t, "reviewees.db", `
+		INSERT INTO rating_categories (id, name, weight) VALUES (1, 'Tone', 1);
+		INSERT INTO tickets (id, subject, created_at) VALUES (1, 'first', '2024-03-04T09:00:00'), (2, 'second', '2024-03-04T09:00:00'), (3, 'third', '2024-03-04T09:00:00');
+		INSERT INTO ratings (rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at) VALUES
+			(5, 1, 1, 1, 11, '2024-03-04T10:00:00'),
+			(1, 2, 1, 1, 12, '2024-03-04T10:00:00'),
+			(4, 3, 1, 1, 20, '2024-03-04T10:00:00');
+	`)
+}
+
+func authzScoreService(t *testing.T) *authz.ScoreService {
+	policy, err := authz.LoadPolicy(writeFile(t, "policy.yaml", testPolicy))
+	assert.Nil(t, err)
+	db := revieweeDatabase(t)
+	return authz.NewScoreService(policy, service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db)))
+}
+
+func asCaller(role string, userID int64) context.Context {
+	return auth.WithIdentity(context.Background(), auth.Identity{Subject: role, WorkspaceID: 1, Role: role, UserID: userID})
+}
+
+func TestPolicyRestrictsRowsByRole(t *testing.T) {
+	scoreService := authzScoreService(t)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	cases := []struct {
+		name    string
+		ctx     context.Context
+		tickets []uint64
+		overall float64
+	}{
+		{"agent", asCaller("agent", 11), []uint64{1}, 100},
+		{"team lead", asCaller("team_lead", 10), []uint64{1, 2}, 60},
+		{"admin", asCaller("admin", 0), []uint64{1, 2, 3}, 66.67},
+	}
+	for _, c := range cases {
+		tickets, err := scoreService.GetScoreByTicket(c.ctx, from, to)
+		assert.Nil(t, err, c.name)
+		var ids []uint64
+		for _, ticket := range tickets {
+			ids = append(ids, ticket.TicketID)
+		}
+		assert.ElementsMatch(t, c.tickets, ids, c.name)
+
+		overall, err := scoreService.GetOverAllQualityScore(c.ctx, from, to)
+		assert.Nil(t, err, c.name)
+		assert.NotNil(t, overall, c.name)
+		assert.InDelta(t, c.overall, lo.FromPtr(overall), 0.01, c.name)
+	}
+
+	overTime, err := scoreService.GetAggregatedCategoryScoresOverTime(asCaller("team_lead", 10), from, to)
+	assert.Nil(t, err)
+	assert.Len(t, overTime, 1)
+	assert.Equal(t, uint32(2), overTime[0].TotalRating)
+}
+
+func TestPolicyDeniesRequests(t *testing.T) {
+	scoreService := authzScoreService(t)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	denied := map[string]context.Context{
+		"unauthenticated": context.Background(),
+		"unknown role":    asCaller("guest", 11),
+		"no user id":      asCaller("agent", 0),
+	}
+	for name, ctx := range denied {
+		_, err := scoreService.GetOverAllQualityScore(ctx, from, to)
+		assert.ErrorIs(t, err, apperror.ErrPermissionDenied, name)
+	}
+
+	_, err := scoreService.GetAggregatedCategoryScoresOverTime(asCaller("agent", 11), from, to)
+	assert.ErrorIs(t, err, apperror.ErrPermissionDenied)
+	assert.Equal(t, codes.PermissionDenied, status.Code(apperror.ToStatus(context.Background(), err)))
+}
+
+func TestPolicyAppliesToEveryDashboardSection(t *testing.T) {
+	scoreService := authzScoreService(t)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	dashboard, err := scoreService.GetDashboard(asCaller("agent", 11), from, to, service.DashboardSections{Overall: true, WorstTickets: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 100.0, lo.FromPtr(dashboard.OverallScore))
+	assert.Equal(t, []service.TicketScore{{TicketID: 1, Score: 100, Ratings: 1}}, dashboard.WorstTickets)
+
+	_, err = scoreService.GetDashboard(asCaller("agent", 11), from, to, service.DashboardSections{Overall: true, CategoriesOverTime: true})
+	assert.ErrorIs(t, err, apperror.ErrPermissionDenied)
+	// Without sections nothing would restrict the call.
+	_, err = scoreService.GetDashboard(asCaller("agent", 11), from, to, service.DashboardSections{})
+	assert.ErrorIs(t, err, apperror.ErrPermissionDenied)
+}
+
+func TestLoadPolicy(t *testing.T) {
+	_, err := authz.LoadPolicy("../policy.example.yaml")
+	assert.Nil(t, err)
+
+	_, err = authz.LoadPolicy(writeFile(t, "policy.yaml", "roles:\n  agent:\n    scope: everyone\n"))
+	assert.ErrorContains(t, err, "scope")
+	_, err = authz.LoadPolicy(writeFile(t, "policy.yaml", "roles:\n  agent:\n    scope: self\n    methods: ['*']\n    extra: true\n"))
+	assert.NotNil(t, err)
+}
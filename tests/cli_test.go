@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/cli"
+	"github.com/fernandoalava/softwareengineer-test-task/gateway"
+	"github.com/fernandoalava/softwareengineer-test-task/health"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/report"
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func cliRange(t *testing.T, value string) util.DateRange {
+	dateRange, err := util.ParseDateRange(value, time.Now())
+	assert.Nil(t, err)
+	return dateRange
+}
+
+// cliServer serves the test database to the v1 and v2 clients the CLI uses.
+func cliServer(t *testing.T) (pb.ScoresClient, pbv2.ScoresClient) {
+	db, err := sql.Open("sqlite", testDatabase)
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	conn, closer := grpcConnWithService(service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db)), util.DefaultRangeLimits)
+	t.Cleanup(closer)
+	return pb.NewScoresClient(conn), pbv2.NewScoresClient(conn)
+}
+
+func TestCLICategoriesTable(t *testing.T) {
+	client, clientV2 := cliServer(t)
+
+	var output bytes.Buffer
+	assert.Nil(t, cli.Query(context.Background(), client, clientV2, "categories", cliRange(t, "2019-07-15..2019-07-18"), cli.FormatTable, &output))
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	assert.Equal(t, []string{"Category", "Ratings", "2019-07-15", "2019-07-16", "2019-07-17", "2019-07-18", "Score"}, strings.Fields(lines[0]))
+	assert.Greater(t, len(lines), 1)
+	for _, line := range lines[1:] {
+		cells := strings.Fields(line)
+		assert.Len(t, cells, 7, line)
+		for _, cell := range cells[2:] {
+			assert.True(t, cell == "N/A" || strings.HasSuffix(cell, "%"), cell)
+		}
+	}
+}
+
+func TestCLITicketsCSV(t *testing.T) {
+	client, clientV2 := cliServer(t)
+
+	var output bytes.Buffer
+	assert.Nil(t, cli.Query(context.Background(), client, clientV2, "tickets", cliRange(t, "2019-07-17"), cli.FormatCSV, &output))
+	records, err := csv.NewReader(&output).ReadAll()
+	assert.Nil(t, err)
+	assert.Greater(t, len(records), 1)
+	assert.Equal(t, "Ticket ID", records[0][0])
+	for _, record := range records[1:] {
+		assert.Len(t, record, len(records[0]))
+	}
+}
+
+func TestCLIJSON(t *testing.T) {
+	client, clientV2 := cliServer(t)
+
+	var output bytes.Buffer
+	assert.Nil(t, cli.Query(context.Background(), client, clientV2, "change", cliRange(t, "2019-07"), cli.FormatJSON, &output))
+	var change map[string]any
+	assert.Nil(t, json.Unmarshal(output.Bytes(), &change))
+	assert.Contains(t, change, "currentPeriod")
+	assert.Contains(t, change, "scoreDifference")
+
+	output.Reset()
+	assert.Nil(t, cli.Query(context.Background(), client, clientV2, "categories", cliRange(t, "2019-07"), cli.FormatJSON, &output))
+	for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+		var category map[string]any
+		assert.Nil(t, json.Unmarshal([]byte(line), &category))
+		assert.Contains(t, category, "categoryName")
+	}
+}
+
+func TestCLIChangeWithoutRatingsIsNotAvailable(t *testing.T) {
+	client, clientV2 := cliServer(t)
+
+	var output bytes.Buffer
+	assert.Nil(t, cli.Query(context.Background(), client, clientV2, "change", cliRange(t, "2030-01"), cli.FormatCSV, &output))
+	records, err := csv.NewReader(&output).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{report.NotAvailable, report.NotAvailable, report.NotAvailable}, []string{records[1][3], records[2][3], records[3][3]})
+}
+
+func TestCLIHealth(t *testing.T) {
+	grpcServer := grpc.NewServer()
+	healthServer := grpchealth.NewServer()
+	healthServer.SetServingStatus(health.LivenessService, healthpb.HealthCheckResponse_SERVING)
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	conn, stop, err := gateway.DialInProcess(grpcServer)
+	assert.Nil(t, err)
+	defer stop()
+
+	var output bytes.Buffer
+	assert.Nil(t, cli.Health(context.Background(), conn, health.LivenessService, &output))
+	assert.Equal(t, "SERVING\n", output.String())
+	assert.ErrorContains(t, cli.Health(context.Background(), conn, "", &output), "NOT_SERVING")
+}
+
+func TestCLIRejectsInvalidArguments(t *testing.T) {
+	noEnv := func(string) (string, bool) { return "", false }
+	for _, args := range [][]string{
+		nil,
+		{"scores"},
+		{"overall", "-output", "xml"},
+		{"overall", "2019-07-31..2019-07-01"},
+		{"overall", "2019-07", "2019-08"},
+	} {
+		assert.NotNil(t, cli.Run(context.Background(), args, &bytes.Buffer{}, noEnv), args)
+	}
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func envLookup(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+}
+
+func writeConfigFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestConfigDefaults(t *testing.T) {
+	cfg, err := config.Load("test", nil, envLookup(map[string]string{"DB_PATH": "database.db"}))
+
+	assert.Nil(t, err)
+	assert.Equal(t, ":9000", cfg.Listen.GRPCAddress)
+	assert.Equal(t, "sqlite", cfg.Database.Driver)
+	assert.Equal(t, "database.db", cfg.Database.DSN)
+	assert.Equal(t, 7*24*time.Hour, cfg.Ranges.DefaultRange)
+}
+
+func TestConfigPrecedence(t *testing.T) {
+	path := writeConfigFile(t, `
+listen:
+  grpc_address: ":7000"
+  metrics_address: ":7001"
+database:
+  dsn: file.db
+  max_open_conns: 4
+logging:
+  level: warn
+`)
+	env := envLookup(map[string]string{
+		"CONFIG_FILE":  path,
+		"METRICS_PORT": "8001",
+		"DB_PATH":      "env.db",
+		"LOG_LEVEL":    "debug",
+	})
+
+	cfg, err := config.Load("test", []string{"-db-dsn", "flag.db", "-max-range", "720h"}, env)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ":7000", cfg.Listen.GRPCAddress)
+	assert.Equal(t, ":8001", cfg.Listen.MetricsAddress)
+	assert.Equal(t, "flag.db", cfg.Database.DSN)
+	assert.Equal(t, 4, cfg.Database.MaxOpenConns)
+	assert.Equal(t, "debug", cfg.Logging.Level)
+	assert.Equal(t, 720*time.Hour, cfg.Ranges.MaxRange)
+}
+
+func TestConfigBooleanFlagsNeedNoValue(t *testing.T) {
+	env := envLookup(map[string]string{"DB_READ_ONLY": "false"})
+
+	cfg, err := config.Load("test", []string{"-db-dsn", "flag.db", "-db-read-only", "-db-immutable"}, env)
+	assert.Nil(t, err)
+	assert.True(t, cfg.Database.ReadOnly)
+	assert.True(t, cfg.Database.Immutable)
+
+	cfg, err = config.Load("test", []string{"-db-dsn", "flag.db", "-db-read-only=false"}, envLookup(nil))
+	assert.Nil(t, err)
+	assert.False(t, cfg.Database.ReadOnly)
+}
+
+func TestConfigValidationReportsEveryProblem(t *testing.T) {
+	env := envLookup(map[string]string{"DB_DRIVER": "postgres", "LOG_LEVEL": "verbose", "TLS_CERT_FILE": "cert.pem", "CATEGORY_ORDER": "size"})
+
+	_, err := config.Load("test", nil, env)
+
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `database.driver "postgres" is not supported`)
+	assert.Contains(t, err.Error(), "database.dsn must be set")
+	assert.Contains(t, err.Error(), `logging.level "verbose"`)
+	assert.Contains(t, err.Error(), "tls.cert_file and tls.key_file must be set together")
+	assert.Contains(t, err.Error(), `scores.category_order "size"`)
+}
+
+func TestConfigRejectsInvalidValues(t *testing.T) {
+	_, err := config.Load("test", nil, envLookup(map[string]string{"DB_PATH": "database.db", "MAX_RANGE": "a month"}))
+	assert.ErrorContains(t, err, "MAX_RANGE")
+
+	_, err = config.Load("test", []string{"-config", writeConfigFile(t, "database:\n  path: x.db\n")}, envLookup(nil))
+	assert.ErrorContains(t, err, "field path not found")
+}
+
+func TestConfigPrintCanBeLoadedBack(t *testing.T) {
+	cfg, err := config.Load("test", []string{"-db-dsn", "database.db", "-max-range", "720h"}, envLookup(nil))
+	assert.Nil(t, err)
+
+	var printed bytes.Buffer
+	assert.Nil(t, config.Print(&printed, cfg))
+	assert.Contains(t, printed.String(), "max_range: 720h0m0s")
+
+	reloaded, err := config.Load("test", []string{"-config", writeConfigFile(t, printed.String())}, envLookup(nil))
+	assert.Nil(t, err)
+	assert.Equal(t, cfg, reloaded)
+}
@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1/scoresv1connect"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func connectRange() *pb.DateRangeRequest {
+	from := time.Date(2019, 7, 17, 0, 0, 0, 0, time.UTC)
+	return &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(from.AddDate(0, 0, 1))}
+}
+
+// h2cClient speaks cleartext HTTP/2, which the gRPC protocol requires.
+func h2cClient() *http.Client {
+	return &http.Client{Transport: &http2.Transport{
+		AllowHTTP: true,
+		DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		},
+	}}
+}
+
+func TestConnectProtocols(t *testing.T) {
+	httpServer := gatewayServer(t, nil)
+	grpcClient, closer := grpcServer()
+	defer closer()
+	stream, err := grpcClient.GetScoreByTicket(context.Background(), connectRange())
+	assert.Nil(t, err)
+	grpcTickets := 0
+	for _, err := stream.Recv(); err == nil; _, err = stream.Recv() {
+		grpcTickets++
+	}
+	assert.Positive(t, grpcTickets)
+
+	clients := map[string]scoresv1connect.ScoresClient{
+		"connect":      scoresv1connect.NewScoresClient(http.DefaultClient, httpServer.URL),
+		"connect json": scoresv1connect.NewScoresClient(http.DefaultClient, httpServer.URL, connect.WithProtoJSON()),
+		"grpc-web":     scoresv1connect.NewScoresClient(http.DefaultClient, httpServer.URL, connect.WithGRPCWeb()),
+		"grpc h2c":     scoresv1connect.NewScoresClient(h2cClient(), httpServer.URL, connect.WithGRPC()),
+	}
+	for name, client := range clients {
+		overall, err := client.GetOverAllQualityScore(context.Background(), connect.NewRequest(connectRange()))
+		assert.Nil(t, err, name)
+		assert.Positive(t, overall.Msg.GetOverAllScore(), name)
+
+		tickets, err := client.GetScoreByTicket(context.Background(), connect.NewRequest(connectRange()))
+		assert.Nil(t, err, name)
+		received := 0
+		for tickets.Receive() {
+			received++
+		}
+		assert.Nil(t, tickets.Err(), name)
+		assert.Equal(t, grpcTickets, received, name)
+	}
+}
+
+func TestConnectErrors(t *testing.T) {
+	httpServer := gatewayServer(t, nil)
+	client := scoresv1connect.NewScoresClient(http.DefaultClient, httpServer.URL, connect.WithGRPCWeb())
+	request := connectRange()
+	request.From, request.To = request.To, request.From
+
+	_, err := client.GetOverAllQualityScore(context.Background(), connect.NewRequest(request))
+	var connectErr *connect.Error
+	assert.True(t, errors.As(err, &connectErr))
+	assert.Equal(t, connect.CodeInvalidArgument, connectErr.Code())
+	assert.Len(t, connectErr.Details(), 1)
+	detail, err := connectErr.Details()[0].Value()
+	assert.Nil(t, err)
+	assert.IsType(t, &errdetails.BadRequest{}, detail)
+
+	stream, err := client.GetAggregatedCategoryScoresOverTime(context.Background(), connect.NewRequest(request))
+	assert.Nil(t, err)
+	assert.False(t, stream.Receive())
+	assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(stream.Err()))
+}
+
+func TestConnectCORS(t *testing.T) {
+	httpServer := gatewayServer(t, nil)
+	preflight := func(origin string) *http.Response {
+		request, err := http.NewRequest(http.MethodOptions, httpServer.URL+scoresv1connect.ScoresGetOverAllQualityScoreProcedure, nil)
+		assert.Nil(t, err)
+		request.Header.Set("Origin", origin)
+		request.Header.Set("Access-Control-Request-Method", http.MethodPost)
+		request.Header.Set("Access-Control-Request-Headers", "connect-protocol-version,content-type,x-api-key")
+		response, err := http.DefaultClient.Do(request)
+		assert.Nil(t, err)
+		_ = response.Body.Close()
+		return response
+	}
+
+	response := preflight("https://app.example")
+	assert.Equal(t, "https://app.example", response.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "3600", response.Header.Get("Access-Control-Max-Age"))
+
+	response = preflight("https://other.example")
+	assert.Empty(t, response.Header.Get("Access-Control-Allow-Origin"))
+}
@@ -0,0 +1,159 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/gateway"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/fernandoalava/softwareengineer-test-task/server"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func dashboardScoreService(t *testing.T) *service.ScoreService {
+	db := revieweeDatabase(t)
+	return service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db)).
+		WithSnapshots(repository.NewSnapshots(db))
+}
+
+func TestDashboardComputesRequestedSections(t *testing.T) {
+	scoreService := dashboardScoreService(t)
+	ctx := workspace.WithID(context.Background(), workspace.DefaultID)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	dashboard, err := scoreService.GetDashboard(ctx, from, to, service.DashboardSections{Overall: true, Change: true, CategoriesOverTime: true, WorstTickets: 2})
+	assert.Nil(t, err)
+	overall, err := scoreService.GetOverAllQualityScore(ctx, from, to)
+	assert.Nil(t, err)
+	change, err := scoreService.GetPeriodOverPeriodScoreChange(ctx, from, to)
+	assert.Nil(t, err)
+	overTime, err := scoreService.GetAggregatedCategoryScoresOverTime(ctx, from, to)
+	assert.Nil(t, err)
+
+	assert.Equal(t, overall, dashboard.OverallScore)
+	assert.Equal(t, change, dashboard.Change)
+	assert.Equal(t, overTime, dashboard.CategoriesOverTime)
+	// Ticket 2 is rated 1 and ticket 3 is rated 4, ticket 1 rated 5 is past the limit.
+	assert.Equal(t, []service.TicketScore{{TicketID: 2, Score: 20, Ratings: 1}, {TicketID: 3, Score: 80, Ratings: 1}}, dashboard.WorstTickets)
+
+	dashboard, err = scoreService.GetDashboard(ctx, from, to, service.DashboardSections{WorstTickets: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, dashboard.OverallScore)
+	assert.Nil(t, dashboard.Change)
+	assert.Empty(t, dashboard.CategoriesOverTime)
+	assert.Len(t, dashboard.WorstTickets, 1)
+}
+
+func TestSnapshotsIgnoreLaterWrites(t *testing.T) {
+	db := revieweeDatabase(t)
+	_, err := db.Exec("PRAGMA journal_mode = WAL")
+	assert.Nil(t, err)
+	scoreRepository := repository.NewScoreRepository(db)
+	ctx := workspace.WithID(context.Background(), workspace.DefaultID)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	err = repository.NewSnapshots(db).Run(ctx, func(ctx context.Context) error {
+		before, err := scoreRepository.FetchOverallQuality(ctx, from, to)
+		assert.Nil(t, err)
+		_, err = db.Exec(`INSERT INTO ratings (rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at) VALUES (0, 1, 1, 1, 11, '2024-03-04T11:00:00')`)
+		assert.Nil(t, err)
+		after, err := scoreRepository.FetchOverallQuality(ctx, from, to)
+		assert.Nil(t, err)
+		assert.Equal(t, before, after)
+		return nil
+	})
+	assert.Nil(t, err)
+
+	// Outside of the snapshot, the new 0 rating lowers the score from 66.67 to 50.
+	score, err := scoreRepository.FetchOverallQuality(ctx, from, to)
+	assert.Nil(t, err)
+	assert.Equal(t, 50.0, lo.FromPtr(score))
+}
+
+func TestSnapshotsBypassTheCategoryCache(t *testing.T) {
+	db := revieweeDatabase(t)
+	categories := repository.NewCachedRatingCategoryRepository(repository.NewRatingCategoryRepository(db), time.Minute)
+	ctx := workspace.WithID(context.Background(), workspace.DefaultID)
+
+	cached, err := categories.FetchAll(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, cached, 1)
+	_, err = db.Exec(`INSERT INTO rating_categories (id, name, weight) VALUES (2, 'Grammar', 1)`)
+	assert.Nil(t, err)
+
+	err = repository.NewSnapshots(db).Run(ctx, func(ctx context.Context) error {
+		snapshot, err := categories.FetchAll(ctx)
+		assert.Len(t, snapshot, 2)
+		return err
+	})
+	assert.Nil(t, err)
+	cached, err = categories.FetchAll(ctx)
+	assert.Nil(t, err)
+	assert.Len(t, cached, 1)
+}
+
+func TestDashboardRPC(t *testing.T) {
+	scoreService := dashboardScoreService(t)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(workspace.UnaryServerInterceptor(workspace.DefaultID)),
+	)
+	pbv2.RegisterScoresServer(grpcServer, server.NewScoreServerV2(scoreService, util.DefaultRangeLimits))
+	conn, stop, err := gateway.DialInProcess(grpcServer)
+	assert.Nil(t, err)
+	t.Cleanup(stop)
+	client := pbv2.NewScoresClient(conn)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	request := func(limit int32, sections ...pbv2.DashboardSection) *pbv2.DashboardRequest {
+		return &pbv2.DashboardRequest{From: timestamppb.New(from), To: timestamppb.New(from.AddDate(0, 0, 1)), Sections: sections, WorstTicketsLimit: limit}
+	}
+
+	response, err := client.GetDashboard(context.Background(), request(0, pbv2.DashboardSection_DASHBOARD_SECTION_OVERALL, pbv2.DashboardSection_DASHBOARD_SECTION_WORST_TICKETS))
+	assert.Nil(t, err)
+	assert.Equal(t, 66.67, response.GetOverall().GetOverallScore())
+	assert.Nil(t, response.Change)
+	assert.Empty(t, response.GetCategoriesOverTime())
+	assert.Equal(t, []int64{2, 3, 1}, lo.Map(response.GetWorstTickets(), func(ticket *pbv2.TicketScore, _ int) int64 {
+		return ticket.GetTicketId()
+	}))
+
+	response, err = client.GetDashboard(context.Background(), request(1, pbv2.DashboardSection_DASHBOARD_SECTION_CHANGE, pbv2.DashboardSection_DASHBOARD_SECTION_CATEGORIES_OVER_TIME, pbv2.DashboardSection_DASHBOARD_SECTION_WORST_TICKETS))
+	assert.Nil(t, err)
+	assert.Nil(t, response.Overall)
+	assert.NotNil(t, response.GetChange().GetCurrentPeriod())
+	assert.Len(t, response.GetCategoriesOverTime(), 1)
+	assert.Len(t, response.GetWorstTickets(), 1)
+
+	invalid := map[string]*pbv2.DashboardRequest{
+		"no sections":    request(0),
+		"unspecified":    request(0, pbv2.DashboardSection_DASHBOARD_SECTION_UNSPECIFIED),
+		"limit too high": request(server.MaxWorstTickets+1, pbv2.DashboardSection_DASHBOARD_SECTION_WORST_TICKETS),
+		"negative limit": request(-1, pbv2.DashboardSection_DASHBOARD_SECTION_WORST_TICKETS),
+		"reversed range": {From: timestamppb.New(from), To: timestamppb.New(from.AddDate(0, 0, -1)), Sections: []pbv2.DashboardSection{pbv2.DashboardSection_DASHBOARD_SECTION_OVERALL}},
+	}
+	for name, invalidRequest := range invalid {
+		_, err := client.GetDashboard(context.Background(), invalidRequest)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+}
+
+func TestGatewayServesDashboard(t *testing.T) {
+	httpServer := gatewayServer(t, dashboardScoreService(t))
+
+	response, body := getJSON(t, httpServer.URL+"/v2/scores/dashboard?from=2024-03-04T00:00:00Z&to=2024-03-05T00:00:00Z&sections=DASHBOARD_SECTION_OVERALL&sections=DASHBOARD_SECTION_WORST_TICKETS&worst_tickets_limit=1", nil)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, map[string]any{"overallScore": 66.67}, body["overall"])
+	assert.Len(t, body["worstTickets"], 1)
+}
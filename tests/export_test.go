@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/report"
+	"github.com/fernandoalava/softwareengineer-test-task/server"
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func exportRequest(from, to time.Time, reportType pb.ReportType, format pb.ExportFormat) *pb.ExportReportRequest {
+	return &pb.ExportReportRequest{From: timestamppb.New(from), To: timestamppb.New(to), Report: reportType, Format: format}
+}
+
+// exportFile reassembles the exported file and returns it with its chunks.
+func exportFile(t *testing.T, client pb.ScoresClient, request *pb.ExportReportRequest) ([]byte, []*pb.ExportChunk) {
+	stream, err := client.ExportReport(context.Background(), request)
+	assert.Nil(t, err)
+	var file bytes.Buffer
+	var chunks []*pb.ExportChunk
+	for {
+		chunk, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return file.Bytes(), chunks
+		}
+		assert.Nil(t, err)
+		if err != nil {
+			return nil, nil
+		}
+		file.Write(chunk.GetData())
+		chunks = append(chunks, chunk)
+	}
+}
+
+func TestExportCategoriesOverTimeCSV(t *testing.T) {
+	client, closer := grpcServer()
+	defer closer()
+	from := time.Date(2019, 7, 15, 0, 0, 0, 0, time.UTC)
+
+	file, chunks := exportFile(t, client, exportRequest(from, from.AddDate(0, 0, 4), pb.ReportType_REPORT_TYPE_CATEGORIES_OVER_TIME, pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED))
+	assert.Equal(t, "scores-categories-over-time_2019-07-15_2019-07-19.csv", chunks[0].GetFileName())
+	assert.Equal(t, report.CSVContentType, chunks[0].GetContentType())
+
+	records, err := csv.NewReader(bytes.NewReader(file)).ReadAll()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Category", "Ratings", "2019-07-15", "2019-07-16", "2019-07-17", "2019-07-18", "Score"}, records[0])
+	assert.Contains(t, records, []string{"Randomness", "16", "N/A", "N/A", "N/A", "N/A", "N/A"})
+}
+
+func TestExportTicketsXLSX(t *testing.T) {
+	client, closer := grpcServer()
+	defer closer()
+	from := time.Date(2019, 7, 17, 0, 0, 0, 0, time.UTC)
+
+	file, chunks := exportFile(t, client, exportRequest(from, from.AddDate(0, 0, 1), pb.ReportType_REPORT_TYPE_TICKETS, pb.ExportFormat_EXPORT_FORMAT_XLSX))
+	assert.Equal(t, "scores-tickets_2019-07-17_2019-07-18.xlsx", chunks[0].GetFileName())
+	assert.Equal(t, report.XLSXContentType, chunks[0].GetContentType())
+
+	workbook, err := excelize.OpenReader(bytes.NewReader(file))
+	assert.Nil(t, err)
+	defer workbook.Close()
+	rows, err := workbook.GetRows("tickets")
+	assert.Nil(t, err)
+	assert.Equal(t, "Ticket ID", rows[0][0])
+	assert.Greater(t, len(rows), 1)
+	// Scores are stored as fractions with a percentage format.
+	value, err := workbook.GetCellValue("tickets", "B2", excelize.Options{RawCellValue: true})
+	assert.Nil(t, err)
+	formatted, err := workbook.GetCellValue("tickets", "B2")
+	assert.Nil(t, err)
+	if formatted != report.NotAvailable {
+		assert.NotContains(t, value, "%")
+		assert.Contains(t, formatted, "%")
+	}
+}
+
+func TestExportSplitsLargeFilesInChunks(t *testing.T) {
+	defer func(size int) { server.ExportChunkSize = size }(server.ExportChunkSize)
+	server.ExportChunkSize = 4096
+	client, closer := grpcServer()
+	defer closer()
+	from := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	file, chunks := exportFile(t, client, exportRequest(from, from.AddDate(1, 0, 0), pb.ReportType_REPORT_TYPE_TICKETS, pb.ExportFormat_EXPORT_FORMAT_CSV))
+	assert.Greater(t, len(chunks), 1)
+	for i, chunk := range chunks {
+		assert.LessOrEqual(t, len(chunk.GetData()), server.ExportChunkSize)
+		if i > 0 {
+			assert.Empty(t, chunk.GetFileName())
+		}
+	}
+	_, err := csv.NewReader(bytes.NewReader(file)).ReadAll()
+	assert.Nil(t, err)
+}
+
+func TestExportRejectsInvalidRequests(t *testing.T) {
+	client, closer := grpcServer()
+	defer closer()
+	from := time.Date(2019, 7, 17, 0, 0, 0, 0, time.UTC)
+
+	for _, request := range []*pb.ExportReportRequest{
+		exportRequest(from, from.AddDate(0, 0, 1), pb.ReportType_REPORT_TYPE_UNSPECIFIED, pb.ExportFormat_EXPORT_FORMAT_CSV),
+		exportRequest(from, from.AddDate(0, 0, 1), pb.ReportType_REPORT_TYPE_OVERALL, pb.ExportFormat(42)),
+		exportRequest(from, from, pb.ReportType_REPORT_TYPE_OVERALL, pb.ExportFormat_EXPORT_FORMAT_CSV),
+	} {
+		stream, err := client.ExportReport(context.Background(), request)
+		assert.Nil(t, err)
+		_, err = stream.Recv()
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	}
+}
+
+func TestReportFormatsCells(t *testing.T) {
+	table := &report.Table{
+		Header: []string{"Name", "Score", "Change", "Empty", "Other"},
+		Rows: [][]any{
+			{"up", report.Score(96.666666), report.Change(0.5), nil, 1.5},
+			{"down", report.Score(40), report.Change(-0.25), nil, true},
+		},
+	}
+
+	assert.Equal(t, [][]string{
+		{"Name", "Score", "Change", "Empty", "Other"},
+		{"up", "96.67%", "+50%", "N/A", "1.5"},
+		{"down", "40%", "-25%", "N/A", "true"},
+	}, table.Strings())
+}
@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"bufio"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	"github.com/fernandoalava/softwareengineer-test-task/gateway"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/ratelimit"
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/fernandoalava/softwareengineer-test-task/server"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+const gatewayRange = "from=2019-07-17T00:00:00Z&to=2019-07-18T00:00:00Z"
+
+// gatewayServer serves the REST gateway of both API versions over scoreService, or over the test database when it is
+// nil; serverOptions are applied after the default workspace interceptors.
+func gatewayServer(t *testing.T, scoreService server.ScoreService, serverOptions ...grpc.ServerOption) *httptest.Server {
+	if scoreService == nil {
+		db, err := sql.Open("sqlite", testDatabase)
+		assert.Nil(t, err)
+		t.Cleanup(func() { _ = db.Close() })
+		scoreService = service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db))
+	}
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(ratelimit.UnaryServerInterceptor(), workspace.UnaryServerInterceptor(workspace.DefaultID)),
+		grpc.ChainStreamInterceptor(ratelimit.StreamServerInterceptor(), workspace.StreamServerInterceptor(workspace.DefaultID)),
+	}, serverOptions...)...)
+	pb.RegisterScoresServer(grpcServer, server.NewScoreServer(scoreService, util.DefaultRangeLimits))
+	pbv2.RegisterScoresServer(grpcServer, server.NewScoreServerV2(scoreService, util.DefaultRangeLimits))
+	conn, stop, err := gateway.DialInProcess(grpcServer)
+	assert.Nil(t, err)
+	t.Cleanup(stop)
+	handler, err := gateway.NewHandler(context.Background(), conn, gateway.Options{AllowedOrigins: []string{"https://app.example"}, CORSMaxAge: time.Hour, TrustedProxies: []string{"127.0.0.0/8"}})
+	assert.Nil(t, err)
+	httpServer := httptest.NewServer(handler)
+	t.Cleanup(httpServer.Close)
+	return httpServer
+}
+
+func getJSON(t *testing.T, url string, header http.Header) (*http.Response, map[string]any) {
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	assert.Nil(t, err)
+	for key, values := range header {
+		request.Header[key] = values
+	}
+	response, err := http.DefaultClient.Do(request)
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	var body map[string]any
+	assert.Nil(t, json.NewDecoder(response.Body).Decode(&body))
+	return response, body
+}
+
+func TestGatewayUnary(t *testing.T) {
+	httpServer := gatewayServer(t, nil)
+
+	response, body := getJSON(t, httpServer.URL+"/v1/scores/overall?"+gatewayRange, nil)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, body, "overAllScore")
+
+	response, body = getJSON(t, httpServer.URL+"/v1/scores/period-over-period?"+gatewayRange, nil)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, body, "CurrentPeriod")
+
+	response, body = getJSON(t, httpServer.URL+"/v1/scores/overall?from=2019-07-18T00:00:00Z&to=2019-07-17T00:00:00Z", nil)
+	assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+	assert.Contains(t, body["message"], "must be before")
+}
+
+func TestGatewayStreamsNDJSON(t *testing.T) {
+	httpServer := gatewayServer(t, nil)
+
+	for _, path := range []string{"/v1/scores/tickets", "/v1/scores/categories/over-time"} {
+		response, err := http.Get(httpServer.URL + path + "?" + gatewayRange)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, gateway.NDJSONContentType, response.Header.Get("Content-Type"))
+
+		lines := 0
+		scanner := bufio.NewScanner(response.Body)
+		for scanner.Scan() {
+			var line map[string]any
+			assert.Nil(t, json.Unmarshal(scanner.Bytes(), &line), path)
+			assert.Contains(t, line, "result", path)
+			lines++
+		}
+		assert.Nil(t, scanner.Err())
+		assert.Positive(t, lines, path)
+		_ = response.Body.Close()
+	}
+}
+
+func TestGatewayForwardsCredentialsAndRetryAfter(t *testing.T) {
+	authenticator := testAuthenticator(t)
+	db, err := sql.Open("sqlite", testDatabase)
+	assert.Nil(t, err)
+	defer db.Close()
+	limiter := ratelimit.NewLimiter(ratelimit.Options{CostPerSecond: 1, Burst: 1})
+	scoreService := ratelimit.NewScoreService(limiter, service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db)))
+	httpServer := gatewayServer(t, scoreService,
+		grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(authenticator)),
+		grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(authenticator)),
+	)
+	url := httpServer.URL + "/v1/scores/overall?" + gatewayRange
+
+	response, _ := getJSON(t, url, nil)
+	assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+
+	response, _ = getJSON(t, url, http.Header{"X-Api-Key": {"key-1"}})
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	response, _ = getJSON(t, url, http.Header{"X-Api-Key": {"key-1"}})
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.Equal(t, "1", response.Header.Get("Retry-After"))
+}
+
+func TestGatewayRateLimitsEveryClientSeparately(t *testing.T) {
+	db, err := sql.Open("sqlite", testDatabase)
+	assert.Nil(t, err)
+	defer db.Close()
+	limiter := ratelimit.NewLimiter(ratelimit.Options{CostPerSecond: 1, Burst: 1})
+	scoreService := ratelimit.NewScoreService(limiter, service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db)))
+	url := gatewayServer(t, scoreService).URL + "/v1/scores/overall?" + gatewayRange
+	forwardedFor := func(addresses string) http.Header {
+		return http.Header{"X-Forwarded-For": {addresses}}
+	}
+
+	response, _ := getJSON(t, url, forwardedFor("203.0.113.1"))
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	response, _ = getJSON(t, url, forwardedFor("203.0.113.1"))
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+
+	// The test server is a trusted proxy, so the address it forwards is the one limited, not the
+	// first one which the client could have made up, nor the header the gateway sets itself.
+	response, _ = getJSON(t, url, forwardedFor("203.0.113.2, 203.0.113.1"))
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	response, _ = getJSON(t, url, http.Header{"X-Forwarded-For": {"203.0.113.1"}, "X-Client-Address": {"203.0.113.3"}})
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	for i := range 5 {
+		spoofed := fmt.Sprintf("198.51.100.%d", i)
+		response, _ = getJSON(t, url, http.Header{"X-Forwarded-For": {"203.0.113.1"}, "Grpc-Metadata-X-Client-Address": {spoofed}})
+		assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	}
+	response, _ = getJSON(t, url, forwardedFor("203.0.113.1, 203.0.113.2"))
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+}
+
+func TestGatewayServesOpenAPI(t *testing.T) {
+	httpServer := gatewayServer(t, nil)
+
+	response, err := http.Get(httpServer.URL + gateway.OpenAPIPath)
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	document, err := io.ReadAll(response.Body)
+	assert.Nil(t, err)
+	var openAPI struct {
+		Paths map[string]any `json:"paths"`
+	}
+	assert.Nil(t, json.Unmarshal(document, &openAPI))
+	assert.Contains(t, openAPI.Paths, "/v1/scores/overall")
+	assert.Contains(t, openAPI.Paths, "/v1/scores/tickets")
+	assert.Len(t, openAPI.Paths, len(pb.Scores_ServiceDesc.Methods)+len(pb.Scores_ServiceDesc.Streams))
+}
@@ -9,13 +9,18 @@ import (
 	"net"
 	"testing"
 
+	"github.com/fernandoalava/softwareengineer-test-task/logging"
+	"github.com/fernandoalava/softwareengineer-test-task/metrics"
 	"github.com/fernandoalava/softwareengineer-test-task/repository"
 	"github.com/fernandoalava/softwareengineer-test-task/server"
 	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/tracing"
 	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
 	"github.com/stretchr/testify/assert"
 
-	pb "github.com/fernandoalava/softwareengineer-test-task/grpc"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
@@ -24,23 +29,54 @@ import (
 )
 
 func grpcServer() (pb.ScoresClient, func()) {
-	buffer := 1024 * 1024
-	lis := bufconn.Listen(buffer)
-	baseServer := grpc.NewServer()
+	return grpcServerWithRangeLimits(util.DefaultRangeLimits)
+}
 
-	db, err := sql.Open("sqlite", "../database.db")
+// grpcServerWithRangeLimits starts the Scores server on the test database; serverOptions are
+// applied after the default interceptors, so extra interceptors run after them.
+func grpcServerWithRangeLimits(rangeLimits util.RangeLimits, serverOptions ...grpc.ServerOption) (pb.ScoresClient, func()) {
+	db, err := sql.Open("sqlite", testDatabase)
 	if err != nil {
 		log.Fatal(err)
 	}
+	client, stop := grpcServerWithDatabase(db, rangeLimits, serverOptions...)
+	closer := func() {
+		err := db.Close()
+		if err != nil {
+			log.Fatal("got error when closing the DB connection", err)
+		}
+		stop()
+	}
+	return client, closer
+}
 
+// grpcServerWithDatabase starts the Scores server on db, which the returned closer leaves open.
+func grpcServerWithDatabase(db *sql.DB, rangeLimits util.RangeLimits, serverOptions ...grpc.ServerOption) (pb.ScoresClient, func()) {
 	ratingCategoryRepository := repository.NewRatingCategoryRepository(db)
 	scoreRepository := repository.NewScoreRepository(db)
+	return grpcServerWithService(service.NewScoreService(ratingCategoryRepository, scoreRepository), rangeLimits, serverOptions...)
+}
 
-	scoreService := service.NewScoreService(ratingCategoryRepository, scoreRepository)
+// grpcServerWithService starts the Scores server on scoreService, such as a wrapped service.
+func grpcServerWithService(scoreService server.ScoreService, rangeLimits util.RangeLimits, serverOptions ...grpc.ServerOption) (pb.ScoresClient, func()) {
+	conn, closer := grpcConnWithService(scoreService, rangeLimits, serverOptions...)
+	return pb.NewScoresClient(conn), closer
+}
 
-	server := server.NewScoreServer(scoreService)
+// grpcConnWithService starts the v1 and v2 Scores servers on scoreService and connects to them.
+func grpcConnWithService(scoreService server.ScoreService, rangeLimits util.RangeLimits, serverOptions ...grpc.ServerOption) (*grpc.ClientConn, func()) {
+	buffer := 1024 * 1024
+	lis := bufconn.Listen(buffer)
+	baseServer := grpc.NewServer(append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor(), logging.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(), workspace.UnaryServerInterceptor(workspace.DefaultID)),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor(), logging.StreamServerInterceptor(), metrics.StreamServerInterceptor(), workspace.StreamServerInterceptor(workspace.DefaultID)),
+	}, serverOptions...)...)
+
+	serverV2 := server.NewScoreServerV2(scoreService, rangeLimits)
+	server := server.NewScoreServer(scoreService, rangeLimits)
 
 	pb.RegisterScoresServer(baseServer, server)
+	pbv2.RegisterScoresServer(baseServer, serverV2)
 
 	go func() {
 		if err := baseServer.Serve(lis); err != nil {
@@ -57,20 +93,14 @@ func grpcServer() (pb.ScoresClient, func()) {
 	}
 
 	closer := func() {
-		err := db.Close()
-		if err != nil {
-			log.Fatal("got error when closing the DB connection", err)
-		}
-		err = lis.Close()
+		err := lis.Close()
 		if err != nil {
 			log.Printf("error closing listener: %v", err)
 		}
 		baseServer.Stop()
 	}
 
-	client := pb.NewScoresClient(conn)
-
-	return client, closer
+	return conn, closer
 }
 
 func TestGrpcGetScoreByTicket(t *testing.T) {
@@ -107,7 +137,19 @@ func TestGrpcGetOverAllQualityScore(t *testing.T) {
 	out, err := client.GetOverAllQualityScore(ctx, &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)})
 
 	assert.Nil(t, err)
-	assert.Equal(t, float32(49.37), out.OverAllScore)
+	assert.Equal(t, float32(49.37), out.GetOverAllScore())
+}
+
+func TestGrpcLeavesScoreUnsetWithoutRatings(t *testing.T) {
+	client, closer := grpcServer()
+	defer closer()
+
+	from, _ := util.StringToTime("2018-07-17T00:00:00")
+	to, _ := util.StringToTime("2018-07-18T00:00:00")
+
+	out, err := client.GetOverAllQualityScore(context.TODO(), &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)})
+	assert.Nil(t, err)
+	assert.Nil(t, out.OverAllScore)
 }
 
 func TestGrpcGetAggregatedCategoryScoresOverTime(t *testing.T) {
@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/health"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+	_ "modernc.org/sqlite"
+)
+
+func healthServer(db *sql.DB, interval time.Duration) (healthpb.HealthClient, *health.Checker, func()) {
+	lis := bufconn.Listen(1024 * 1024)
+	baseServer := grpc.NewServer()
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(baseServer, healthServer)
+	checker := health.NewChecker(db, healthServer, interval, "grpc.Scores")
+
+	go func() {
+		if err := baseServer.Serve(lis); err != nil {
+			log.Printf("error serving server: %v", err)
+		}
+	}()
+
+	conn, err := grpc.NewClient("passthrough://bufnet", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Printf("error connecting to server: %v", err)
+	}
+
+	closer := func() {
+		_ = conn.Close()
+		baseServer.Stop()
+	}
+	return healthpb.NewHealthClient(conn), checker, closer
+}
+
+func servingStatus(t *testing.T, client healthpb.HealthClient, service string) healthpb.HealthCheckResponse_ServingStatus {
+	response, err := client.Check(context.TODO(), &healthpb.HealthCheckRequest{Service: service})
+	assert.Nil(t, err)
+	return response.GetStatus()
+}
+
+func TestHealthIsNotServingUntilChecked(t *testing.T) {
+	db, err := sql.Open("sqlite", testDatabase)
+	assert.Nil(t, err)
+	defer db.Close()
+	client, checker, closer := healthServer(db, time.Second)
+	defer closer()
+
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, client, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, client, health.LivenessService))
+
+	assert.Nil(t, checker.Check(context.TODO()))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, client, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, client, "grpc.Scores"))
+}
+
+func TestHealthIsNotServingWithInvalidSchema(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "empty.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	client, checker, closer := healthServer(db, time.Second)
+	defer closer()
+
+	assert.NotNil(t, checker.Check(context.TODO()))
+	assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, client, ""))
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, client, health.LivenessService))
+}
+
+func TestHealthFlipsToNotServingWhenDatabaseFails(t *testing.T) {
+	db, err := sql.Open("sqlite", testDatabase)
+	assert.Nil(t, err)
+	client, checker, closer := healthServer(db, 10*time.Millisecond)
+	defer closer()
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	go checker.Run(ctx)
+
+	assert.Eventually(t, func() bool {
+		return servingStatus(t, client, "") == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, 10*time.Millisecond)
+
+	assert.Nil(t, db.Close())
+
+	assert.Eventually(t, func() bool {
+		return servingStatus(t, client, "") == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/fernandoalava/softwareengineer-test-task/logging"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func captureLogs(level slog.Level) (*bytes.Buffer, func()) {
+	buffer := &bytes.Buffer{}
+	previous := slog.Default()
+	slog.SetDefault(logging.NewLogger(buffer, level))
+	return buffer, func() { slog.SetDefault(previous) }
+}
+
+func logRecords(buffer *bytes.Buffer) []map[string]any {
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(buffer.String()), "\n") {
+		record := map[string]any{}
+		if json.Unmarshal([]byte(line), &record) == nil {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func TestRequestIDIsReturnedAndLogged(t *testing.T) {
+	buffer, restore := captureLogs(slog.LevelInfo)
+	defer restore()
+	client, closer := grpcServer()
+	defer closer()
+
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-17T23:59:00")
+	request := &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)}
+
+	var header metadata.MD
+	ctx := metadata.AppendToOutgoingContext(context.TODO(), logging.RequestIDHeader, "test-request-id")
+	_, err := client.GetOverAllQualityScore(ctx, request, grpc.Header(&header))
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"test-request-id"}, header.Get(logging.RequestIDHeader))
+
+	_, err = client.GetOverAllQualityScore(context.TODO(), request, grpc.Header(&header))
+	assert.Nil(t, err)
+	assert.Len(t, header.Get(logging.RequestIDHeader), 1)
+	assert.NotEqual(t, "test-request-id", header.Get(logging.RequestIDHeader)[0])
+
+	// IDs that are too long or could forge log lines are replaced.
+	for _, invalid := range []string{strings.Repeat("a", 129), `id" level="ERROR`, "id with spaces"} {
+		ctx = metadata.AppendToOutgoingContext(context.TODO(), logging.RequestIDHeader, invalid)
+		_, err = client.GetOverAllQualityScore(ctx, request, grpc.Header(&header))
+		assert.Nil(t, err)
+		assert.Regexp(t, "^[0-9a-f]{32}$", header.Get(logging.RequestIDHeader)[0])
+	}
+
+	records := logRecords(buffer)
+	assert.NotEmpty(t, records)
+	assert.Equal(t, "rpc finished", records[0]["msg"])
+	assert.Equal(t, "test-request-id", records[0]["request_id"])
+	assert.Equal(t, "/scores.v1.Scores/GetOverAllQualityScore", records[0]["method"])
+}
+
+func TestSlowQueriesAreLoggedWithParameters(t *testing.T) {
+	buffer, restore := captureLogs(slog.LevelWarn)
+	defer restore()
+	previousThreshold := repository.SlowQueryThreshold
+	repository.SlowQueryThreshold = 0
+	defer func() { repository.SlowQueryThreshold = previousThreshold }()
+
+	scoreService, closer := getScoreService()
+	defer closer()
+
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-17T23:59:00")
+	_, err := scoreService.GetOverAllQualityScore(logging.WithRequestID(defaultWorkspace(context.TODO()), "slow-request"), from, to)
+	assert.Nil(t, err)
+
+	records := logRecords(buffer)
+	assert.Len(t, records, 1)
+	assert.Equal(t, "slow query", records[0]["msg"])
+	assert.Equal(t, "ScoreRepository.FetchOverallQuality", records[0]["query"])
+	assert.Equal(t, "2019-07-17T00:00:00Z", records[0]["scores.range.from"])
+	assert.Equal(t, "slow-request", records[0]["request_id"])
+}
+
+func TestParseLevel(t *testing.T) {
+	level, err := logging.ParseLevel("debug")
+	assert.Nil(t, err)
+	assert.Equal(t, slog.LevelDebug, level)
+
+	_, err = logging.ParseLevel("verbose")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,52 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
+	_ "modernc.org/sqlite"
+)
+
+// testDatabase is a migrated copy of ../database.db, so tests never modify the fixture.
+var testDatabase string
+
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "scores-tests")
+	if err != nil {
+		log.Fatal(err)
+	}
+	testDatabase = filepath.Join(dir, "database.db")
+	if err := copyMigratedDatabase("../database.db", testDatabase); err != nil {
+		log.Fatal(err)
+	}
+	code := m.Run()
+	_ = os.RemoveAll(dir)
+	os.Exit(code)
+}
+
+func copyMigratedDatabase(source, target string) error {
+	content, err := os.ReadFile(source)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(target, content, 0o600); err != nil {
+		return err
+	}
+	db, err := sql.Open("sqlite", target)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	return repository.Migrate(context.Background(), db)
+}
+
+// defaultWorkspace scopes ctx to the workspace the fixture data was migrated into.
+func defaultWorkspace(ctx context.Context) context.Context {
+	return workspace.WithID(ctx, workspace.DefaultID)
+}
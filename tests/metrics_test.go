@@ -0,0 +1,51 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fernandoalava/softwareengineer-test-task/metrics"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func scrapeMetrics() string {
+	recorder := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+	return recorder.Body.String()
+}
+
+func TestMetricsAreRecordedForRpcsAndQueries(t *testing.T) {
+	client, closer := grpcServer()
+	defer closer()
+
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-17T23:59:00")
+	request := &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)}
+
+	_, err := client.GetOverAllQualityScore(context.TODO(), request)
+	assert.Nil(t, err)
+	stream, err := client.GetScoreByTicket(context.TODO(), request)
+	assert.Nil(t, err)
+	for {
+		_, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if !assert.Nil(t, err) {
+			break
+		}
+	}
+
+	body := scrapeMetrics()
+	assert.Contains(t, body, `scores_grpc_requests_total{code="OK",method="/scores.v1.Scores/GetOverAllQualityScore"}`)
+	assert.Contains(t, body, `scores_grpc_request_duration_seconds_count{code="OK",method="/scores.v1.Scores/GetScoreByTicket"}`)
+	assert.Contains(t, body, `scores_grpc_stream_messages_sent_total{method="/scores.v1.Scores/GetScoreByTicket"}`)
+	assert.Contains(t, body, `scores_repository_query_duration_seconds_count{method="ScoreRepository.FetchOverallQuality",result="success"}`)
+	assert.Contains(t, body, `scores_repository_query_rows_count{method="ScoreRepository.FetchScoreByTicketBetween"}`)
+}
@@ -0,0 +1,364 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// protoBaseline holds the descriptors of the published API, which the current ones must stay
+// wire and JSON compatible with. Regenerate it with -update-proto-baseline once a change has
+// been checked, e.g. after adding a field.
+const protoBaseline = "testdata/proto-baseline.json"
+
+var updateProtoBaseline = flag.Bool("update-proto-baseline", false, "overwrite "+protoBaseline+" with the current proto descriptors")
+
+const modulePath = "github.com/fernandoalava/softwareengineer-test-task"
+
+var (
+	versionedPackage = regexp.MustCompile(`^[a-z][a-z0-9]*(\.[a-z][a-z0-9]*)*\.v[1-9][0-9]*$`)
+	lowerSnakeCase   = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+	upperSnakeCase   = regexp.MustCompile(`^[A-Z][A-Z0-9]*(_[A-Z0-9]+)*$`)
+	pascalCase       = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+)
+
+// camelCaseFieldPackages keep the field names they were published with; renaming a field
+// changes its JSON name, which breaks REST and Connect JSON clients. scores.v2 fixes them.
+var camelCaseFieldPackages = []protoreflect.FullName{"scores.v1"}
+
+func publishedProtoFiles() []protoreflect.FileDescriptor {
+	return []protoreflect.FileDescriptor{pb.File_scores_v1_scores_proto, pbv2.File_scores_v2_scores_proto}
+}
+
+// upperSnake turns ExportFormat into EXPORT_FORMAT.
+func upperSnake(name string) string {
+	var builder strings.Builder
+	for i, r := range name {
+		if i > 0 && unicode.IsUpper(r) {
+			builder.WriteByte('_')
+		}
+		builder.WriteRune(unicode.ToUpper(r))
+	}
+	return builder.String()
+}
+
+// lintProtoFile applies the buf lint rules of the DEFAULT category that protos of this
+// repository follow. RPC_REQUEST_STANDARD_NAME, RPC_RESPONSE_STANDARD_NAME and
+// RPC_REQUEST_RESPONSE_UNIQUE are left out: every RPC shares DateRangeRequest by design.
+func lintProtoFile(file protoreflect.FileDescriptor) []string {
+	var problems []string
+	report := func(rule string, name protoreflect.FullName, format string, args ...any) {
+		problems = append(problems, fmt.Sprintf("%s: %s: %s", rule, name, fmt.Sprintf(format, args...)))
+	}
+	pkg := file.Package()
+	if !versionedPackage.MatchString(string(pkg)) {
+		report("PACKAGE_VERSION_SUFFIX", pkg, "must end with a version, e.g. .v1")
+	}
+	dir := strings.ReplaceAll(string(pkg), ".", "/")
+	if path.Dir(file.Path()) != dir {
+		report("PACKAGE_DIRECTORY_MATCH", pkg, "%s must be in %s/", file.Path(), dir)
+	}
+	if !lowerSnakeCase.MatchString(strings.TrimSuffix(path.Base(file.Path()), ".proto")) {
+		report("FILE_LOWER_SNAKE_CASE", pkg, "%s must be lower_snake_case", file.Path())
+	}
+	goPackage := file.Options().(*descriptorpb.FileOptions).GetGoPackage()
+	if importPath, _, _ := strings.Cut(goPackage, ";"); importPath != modulePath+"/proto/"+dir {
+		report("GO_PACKAGE", pkg, "go_package %q must be the import path %s/proto/%s", goPackage, modulePath, dir)
+	}
+
+	checkEnums := func(enums protoreflect.EnumDescriptors) {
+		for i := range enums.Len() {
+			enum := enums.Get(i)
+			if !pascalCase.MatchString(string(enum.Name())) {
+				report("ENUM_PASCAL_CASE", enum.FullName(), "must be PascalCase")
+			}
+			prefix := upperSnake(string(enum.Name())) + "_"
+			values := enum.Values()
+			if zero := values.Get(0); zero.Number() != 0 || zero.Name() != protoreflect.Name(prefix+"UNSPECIFIED") {
+				report("ENUM_ZERO_VALUE_SUFFIX", enum.FullName(), "the first value must be %sUNSPECIFIED = 0", prefix)
+			}
+			for j := range values.Len() {
+				value := values.Get(j)
+				if !upperSnakeCase.MatchString(string(value.Name())) {
+					report("ENUM_VALUE_UPPER_SNAKE_CASE", value.FullName(), "must be UPPER_SNAKE_CASE")
+				}
+				if !strings.HasPrefix(string(value.Name()), prefix) {
+					report("ENUM_VALUE_PREFIX", value.FullName(), "must start with %s", prefix)
+				}
+			}
+		}
+	}
+	var checkMessages func(messages protoreflect.MessageDescriptors)
+	checkMessages = func(messages protoreflect.MessageDescriptors) {
+		for i := range messages.Len() {
+			message := messages.Get(i)
+			if !pascalCase.MatchString(string(message.Name())) {
+				report("MESSAGE_PASCAL_CASE", message.FullName(), "must be PascalCase")
+			}
+			fields := message.Fields()
+			for j := range fields.Len() {
+				field := fields.Get(j)
+				if !lowerSnakeCase.MatchString(string(field.Name())) && !slices.Contains(camelCaseFieldPackages, pkg) {
+					report("FIELD_LOWER_SNAKE_CASE", field.FullName(), "must be lower_snake_case")
+				}
+			}
+			checkEnums(message.Enums())
+			checkMessages(message.Messages())
+		}
+	}
+	checkEnums(file.Enums())
+	checkMessages(file.Messages())
+
+	services := file.Services()
+	for i := range services.Len() {
+		service := services.Get(i)
+		if !pascalCase.MatchString(string(service.Name())) {
+			report("SERVICE_PASCAL_CASE", service.FullName(), "must be PascalCase")
+		}
+		methods := service.Methods()
+		for j := range methods.Len() {
+			if method := methods.Get(j); !pascalCase.MatchString(string(method.Name())) {
+				report("RPC_PASCAL_CASE", method.FullName(), "must be PascalCase")
+			}
+		}
+	}
+	return problems
+}
+
+func TestProtoLint(t *testing.T) {
+	for _, file := range publishedProtoFiles() {
+		assert.Empty(t, lintProtoFile(file), file.Path())
+	}
+}
+
+func TestProtoLintReportsViolations(t *testing.T) {
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("scores.proto"),
+		Package: proto.String("grpc"),
+		Syntax:  proto.String("proto3"),
+		Options: &descriptorpb.FileOptions{GoPackage: proto.String("/grpc")},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("ScoreByTicket"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("ratingCategoryID"),
+				JsonName: proto.String("ratingCategoryID"),
+				Number:   proto.Int32(1),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_INT64.Enum(),
+			}},
+		}},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name:  proto.String("ReportType"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{{Name: proto.String("TICKETS"), Number: proto.Int32(0)}},
+		}},
+	}, nil)
+	assert.Nil(t, err)
+
+	var rules []string
+	for _, problem := range lintProtoFile(file) {
+		rule, _, _ := strings.Cut(problem, ":")
+		rules = append(rules, rule)
+	}
+	assert.ElementsMatch(t, []string{
+		"PACKAGE_VERSION_SUFFIX", "PACKAGE_DIRECTORY_MATCH", "GO_PACKAGE", "FIELD_LOWER_SNAKE_CASE",
+		"ENUM_ZERO_VALUE_SUFFIX", "ENUM_VALUE_PREFIX",
+	}, rules)
+}
+
+func currentProtoDescriptors() *descriptorpb.FileDescriptorSet {
+	set := &descriptorpb.FileDescriptorSet{}
+	for _, file := range publishedProtoFiles() {
+		set.File = append(set.File, protodesc.ToFileDescriptorProto(file))
+	}
+	return set
+}
+
+func isReserved(number int32, ranges []*descriptorpb.DescriptorProto_ReservedRange) bool {
+	return slices.ContainsFunc(ranges, func(reserved *descriptorpb.DescriptorProto_ReservedRange) bool {
+		return reserved.GetStart() <= number && number < reserved.GetEnd()
+	})
+}
+
+func isReservedValue(number int32, ranges []*descriptorpb.EnumDescriptorProto_EnumReservedRange) bool {
+	return slices.ContainsFunc(ranges, func(reserved *descriptorpb.EnumDescriptorProto_EnumReservedRange) bool {
+		return reserved.GetStart() <= number && number <= reserved.GetEnd()
+	})
+}
+
+// breakingChanges applies the buf breaking rules of the WIRE_JSON category: what would stop
+// existing gRPC, REST or Connect clients from working. Additions are always allowed; a removed
+// field or enum value must have its number reserved.
+func breakingChanges(baseline, current *descriptorpb.FileDescriptorSet) []string {
+	var problems []string
+	report := func(name string, format string, args ...any) {
+		problems = append(problems, fmt.Sprintf("%s: %s", name, fmt.Sprintf(format, args...)))
+	}
+	find := func(set *descriptorpb.FileDescriptorSet, name string) *descriptorpb.FileDescriptorProto {
+		index := slices.IndexFunc(set.GetFile(), func(file *descriptorpb.FileDescriptorProto) bool { return file.GetName() == name })
+		if index < 0 {
+			return nil
+		}
+		return set.GetFile()[index]
+	}
+
+	compareEnums := func(scope string, previous, next []*descriptorpb.EnumDescriptorProto) {
+		for _, enum := range previous {
+			name := scope + "." + enum.GetName()
+			index := slices.IndexFunc(next, func(e *descriptorpb.EnumDescriptorProto) bool { return e.GetName() == enum.GetName() })
+			if index < 0 {
+				report(name, "enum deleted")
+				continue
+			}
+			nextEnum := next[index]
+			for _, value := range enum.GetValue() {
+				nextIndex := slices.IndexFunc(nextEnum.GetValue(), func(v *descriptorpb.EnumValueDescriptorProto) bool { return v.GetNumber() == value.GetNumber() })
+				switch {
+				case nextIndex < 0 && !isReservedValue(value.GetNumber(), nextEnum.GetReservedRange()):
+					report(name, "value %d deleted without being reserved", value.GetNumber())
+				case nextIndex >= 0 && nextEnum.GetValue()[nextIndex].GetName() != value.GetName():
+					report(name, "value %d renamed from %s", value.GetNumber(), value.GetName())
+				}
+			}
+		}
+	}
+	var compareMessages func(scope string, previous, next []*descriptorpb.DescriptorProto)
+	compareMessages = func(scope string, previous, next []*descriptorpb.DescriptorProto) {
+		for _, message := range previous {
+			name := scope + "." + message.GetName()
+			index := slices.IndexFunc(next, func(m *descriptorpb.DescriptorProto) bool { return m.GetName() == message.GetName() })
+			if index < 0 {
+				report(name, "message deleted")
+				continue
+			}
+			nextMessage := next[index]
+			for _, field := range message.GetField() {
+				nextIndex := slices.IndexFunc(nextMessage.GetField(), func(f *descriptorpb.FieldDescriptorProto) bool { return f.GetNumber() == field.GetNumber() })
+				if nextIndex < 0 {
+					if !isReserved(field.GetNumber(), nextMessage.GetReservedRange()) {
+						report(name, "field %d deleted without being reserved", field.GetNumber())
+					}
+					continue
+				}
+				nextField := nextMessage.GetField()[nextIndex]
+				switch {
+				case nextField.GetName() != field.GetName() || nextField.GetJsonName() != field.GetJsonName():
+					report(name, "field %d renamed from %s", field.GetNumber(), field.GetName())
+				case nextField.GetType() != field.GetType() || nextField.GetTypeName() != field.GetTypeName():
+					report(name, "field %d changed type", field.GetNumber())
+				case nextField.GetLabel() != field.GetLabel() || nextField.GetProto3Optional() != field.GetProto3Optional():
+					report(name, "field %d changed cardinality", field.GetNumber())
+				case (nextField.OneofIndex == nil) != (field.OneofIndex == nil):
+					report(name, "field %d moved in or out of a oneof", field.GetNumber())
+				}
+			}
+			compareEnums(name, message.GetEnumType(), nextMessage.GetEnumType())
+			compareMessages(name, message.GetNestedType(), nextMessage.GetNestedType())
+		}
+	}
+
+	for _, file := range baseline.GetFile() {
+		nextFile := find(current, file.GetName())
+		if nextFile == nil {
+			report(file.GetName(), "file deleted")
+			continue
+		}
+		if nextFile.GetPackage() != file.GetPackage() {
+			report(file.GetName(), "package changed from %s", file.GetPackage())
+			continue
+		}
+		scope := file.GetPackage()
+		compareMessages(scope, file.GetMessageType(), nextFile.GetMessageType())
+		compareEnums(scope, file.GetEnumType(), nextFile.GetEnumType())
+		for _, service := range file.GetService() {
+			name := scope + "." + service.GetName()
+			index := slices.IndexFunc(nextFile.GetService(), func(s *descriptorpb.ServiceDescriptorProto) bool { return s.GetName() == service.GetName() })
+			if index < 0 {
+				report(name, "service deleted")
+				continue
+			}
+			nextMethods := nextFile.GetService()[index].GetMethod()
+			for _, method := range service.GetMethod() {
+				methodIndex := slices.IndexFunc(nextMethods, func(m *descriptorpb.MethodDescriptorProto) bool { return m.GetName() == method.GetName() })
+				if methodIndex < 0 {
+					report(name, "rpc %s deleted", method.GetName())
+					continue
+				}
+				nextMethod := nextMethods[methodIndex]
+				switch {
+				case nextMethod.GetInputType() != method.GetInputType() || nextMethod.GetOutputType() != method.GetOutputType():
+					report(name, "rpc %s changed its request or response type", method.GetName())
+				case nextMethod.GetClientStreaming() != method.GetClientStreaming() || nextMethod.GetServerStreaming() != method.GetServerStreaming():
+					report(name, "rpc %s changed streaming", method.GetName())
+				case !proto.Equal(httpRule(method), httpRule(nextMethod)):
+					report(name, "rpc %s changed its HTTP rule", method.GetName())
+				}
+			}
+		}
+	}
+	return problems
+}
+
+func httpRule(method *descriptorpb.MethodDescriptorProto) *annotations.HttpRule {
+	if method.GetOptions() == nil {
+		return nil
+	}
+	return proto.GetExtension(method.GetOptions(), annotations.E_Http).(*annotations.HttpRule)
+}
+
+func TestProtoHasNoBreakingChanges(t *testing.T) {
+	current := currentProtoDescriptors()
+	if *updateProtoBaseline {
+		content, err := protojson.Marshal(current)
+		assert.Nil(t, err)
+		// protojson output is deliberately unstable, indent it so the baseline diffs cleanly.
+		var indented bytes.Buffer
+		assert.Nil(t, json.Indent(&indented, content, "", "  "))
+		indented.WriteByte('\n')
+		assert.Nil(t, os.WriteFile(protoBaseline, indented.Bytes(), 0o644))
+	}
+	content, err := os.ReadFile(protoBaseline)
+	assert.Nil(t, err)
+	baseline := &descriptorpb.FileDescriptorSet{}
+	assert.Nil(t, protojson.Unmarshal(content, baseline))
+
+	assert.Empty(t, breakingChanges(baseline, current))
+}
+
+func TestBreakingChangesAreReported(t *testing.T) {
+	baseline := currentProtoDescriptors()
+	current := proto.Clone(baseline).(*descriptorpb.FileDescriptorSet)
+	v2 := current.GetFile()[1]
+	message := v2.GetMessageType()[slices.IndexFunc(v2.GetMessageType(), func(m *descriptorpb.DescriptorProto) bool {
+		return m.GetName() == "CategoryScoreOverTime"
+	})]
+	message.Field = slices.DeleteFunc(message.Field, func(f *descriptorpb.FieldDescriptorProto) bool { return f.GetNumber() == 5 })
+	message.Field[0].Name = proto.String("name")
+	v2.GetService()[0].Method[0].ServerStreaming = proto.Bool(false)
+
+	assert.ElementsMatch(t, []string{
+		"scores.v2.CategoryScoreOverTime: field 5 deleted without being reserved",
+		"scores.v2.CategoryScoreOverTime: field 1 renamed from category_name",
+		"scores.v2.Scores: rpc GetScoreByTicket changed streaming",
+	}, breakingChanges(baseline, current))
+
+	message.ReservedRange = append(message.ReservedRange, &descriptorpb.DescriptorProto_ReservedRange{Start: proto.Int32(5), End: proto.Int32(6)})
+	assert.Len(t, breakingChanges(baseline, current), 2)
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestResolveTimeRange(t *testing.T) {
+	now, _ := util.StringToTime("2019-07-20T12:00:00")
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-18T00:00:00")
+	week := 7 * 24 * time.Hour
+	limits := util.RangeLimits{DefaultRange: week, MaxRange: 31 * 24 * time.Hour}
+	future := now.Add(time.Hour)
+	twoMonthsBefore := to.AddDate(0, -2, 0)
+	twoYearsBefore := to.AddDate(-2, 0, 0)
+	zero := time.Time{}
+
+	cases := []struct {
+		name         string
+		from         *time.Time
+		to           *time.Time
+		limits       util.RangeLimits
+		expectedFrom time.Time
+		expectedTo   time.Time
+		invalid      bool
+	}{
+		{name: "both missing", limits: limits, expectedFrom: now.Add(-week), expectedTo: now},
+		{name: "from missing", to: &to, limits: limits, expectedFrom: to.Add(-week), expectedTo: to},
+		{name: "to missing", from: &from, limits: limits, expectedFrom: from, expectedTo: now},
+		{name: "both present", from: &from, to: &to, limits: limits, expectedFrom: from, expectedTo: to},
+		{name: "reversed", from: &to, to: &from, limits: limits, invalid: true},
+		{name: "empty", from: &from, to: &from, limits: limits, invalid: true},
+		{name: "to missing and from in future", from: &future, limits: limits, invalid: true},
+		{name: "longer than max range", from: &twoMonthsBefore, to: &to, limits: limits, invalid: true},
+		{name: "zero from", from: &zero, to: &to, limits: limits, invalid: true},
+		{name: "zero to", from: &from, to: &zero, limits: limits, invalid: true},
+		{name: "unlimited", from: &twoYearsBefore, to: &to, limits: util.RangeLimits{DefaultRange: week}, expectedFrom: twoYearsBefore, expectedTo: to},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			resolvedFrom, resolvedTo, err := util.ResolveTimeRange(c.from, c.to, now, c.limits)
+			if c.invalid {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, c.expectedFrom, resolvedFrom)
+			assert.Equal(t, c.expectedTo, resolvedTo)
+		})
+	}
+}
+
+func TestGrpcMissingTimestampsUseDefaultRange(t *testing.T) {
+	client, closer := grpcServer()
+	defer closer()
+
+	to, _ := util.StringToTime("2019-07-18T00:00:00")
+
+	_, err := client.GetOverAllQualityScore(context.TODO(), &pb.DateRangeRequest{})
+	assert.Nil(t, err)
+	_, err = client.GetOverAllQualityScore(context.TODO(), &pb.DateRangeRequest{To: timestamppb.New(to)})
+	assert.Nil(t, err)
+}
+
+func TestGrpcInvalidTimestampIsRejected(t *testing.T) {
+	client, closer := grpcServer()
+	defer closer()
+
+	_, err := client.GetOverAllQualityScore(context.TODO(), &pb.DateRangeRequest{From: &timestamppb.Timestamp{Nanos: -1}})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGrpcZeroTimestampIsRejected(t *testing.T) {
+	client, closer := grpcServer()
+	defer closer()
+
+	to, _ := util.StringToTime("2019-07-18T00:00:00")
+
+	_, err := client.GetOverAllQualityScore(context.TODO(), &pb.DateRangeRequest{From: timestamppb.New(time.Time{}), To: timestamppb.New(to)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "zero time")
+
+	_, err = client.GetOverAllQualityScore(context.TODO(), &pb.DateRangeRequest{To: timestamppb.New(time.Time{})})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGrpcRangeLongerThanMaxRangeIsRejected(t *testing.T) {
+	client, closer := grpcServerWithRangeLimits(util.RangeLimits{DefaultRange: 24 * time.Hour, MaxRange: 31 * 24 * time.Hour})
+	defer closer()
+
+	from, _ := util.StringToTime("2019-01-01T00:00:00")
+	to, _ := util.StringToTime("2019-07-01T00:00:00")
+
+	_, err := client.GetOverAllQualityScore(context.TODO(), &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
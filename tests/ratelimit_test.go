@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	"github.com/fernandoalava/softwareengineer-test-task/metrics"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/ratelimit"
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func asClient(subject string) context.Context {
+	return auth.WithIdentity(context.Background(), auth.Identity{Subject: subject, Method: auth.MethodAPIKey, WorkspaceID: 1})
+}
+
+// queuedHeavyQueries reads the gauge of heavy queries waiting for a slot.
+func queuedHeavyQueries(t *testing.T) float64 {
+	families, err := metrics.Registry.Gather()
+	assert.Nil(t, err)
+	for _, family := range families {
+		if family.GetName() != "scores_ratelimit_heavy_queries" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if metric.GetLabel()[0].GetValue() == "queued" {
+				return metric.GetGauge().GetValue()
+			}
+		}
+	}
+	return 0
+}
+
+func TestEstimateCost(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 1, ratelimit.EstimateCost("GetOverAllQualityScore", from, from.Add(time.Hour)))
+	assert.Equal(t, 7, ratelimit.EstimateCost("GetScoreByTicket", from, from.AddDate(0, 0, 7)))
+	assert.Equal(t, 14, ratelimit.EstimateCost("GetPeriodOverPeriodScoreChange", from, from.AddDate(0, 0, 7)))
+	// Seven daily buckets.
+	assert.Equal(t, 14, ratelimit.EstimateCost("GetAggregatedCategoryScoresOverTime", from, from.AddDate(0, 0, 7)))
+	// Two years of weekly buckets.
+	assert.Equal(t, 731+105, ratelimit.EstimateCost("GetAggregatedCategoryScoresOverTime", from, from.AddDate(2, 0, 0)))
+}
+
+func TestLimiterRateLimitsPerClient(t *testing.T) {
+	limiter := ratelimit.NewLimiter(ratelimit.Options{CostPerSecond: 1, Burst: 10})
+
+	release, err := limiter.Admit(asClient("first"), "GetScoreByTicket", 10)
+	assert.Nil(t, err)
+	release()
+
+	_, err = limiter.Admit(asClient("first"), "GetScoreByTicket", 5)
+	var exhausted *apperror.ResourceExhaustedError
+	assert.ErrorAs(t, err, &exhausted)
+	assert.InDelta(t, 5*time.Second, exhausted.RetryAfter, float64(100*time.Millisecond))
+
+	// Calls costing more than the burst are charged the burst instead of never being admitted.
+	release, err = limiter.Admit(asClient("second"), "GetScoreByTicket", 500)
+	assert.Nil(t, err)
+	release()
+}
+
+func TestLimiterQueuesHeavyQueries(t *testing.T) {
+	limiter := ratelimit.NewLimiter(ratelimit.Options{HeavyCost: 100, MaxConcurrentHeavy: 1, MaxQueuedHeavy: 1, QueueTimeout: 50 * time.Millisecond})
+
+	release, err := limiter.Admit(asClient("first"), "GetAggregatedCategoryScoresOverTime", 100)
+	assert.Nil(t, err)
+
+	// Light queries are never queued.
+	releaseLight, err := limiter.Admit(asClient("second"), "GetOverAllQualityScore", 1)
+	assert.Nil(t, err)
+	releaseLight()
+
+	queued := make(chan error)
+	go func() {
+		release, err := limiter.Admit(asClient("second"), "GetAggregatedCategoryScoresOverTime", 100)
+		if err == nil {
+			release()
+		}
+		queued <- err
+	}()
+	assert.Eventually(t, func() bool { return queuedHeavyQueries(t) == 1 }, time.Second, time.Millisecond)
+	_, err = limiter.Admit(asClient("third"), "GetAggregatedCategoryScoresOverTime", 100)
+	var exhausted *apperror.ResourceExhaustedError
+	assert.ErrorAs(t, err, &exhausted)
+	assert.Equal(t, "too many heavy queries", exhausted.Reason)
+
+	assert.ErrorAs(t, <-queued, &exhausted)
+	assert.Equal(t, "timed out waiting for a heavy query slot", exhausted.Reason)
+
+	go func() {
+		release, err := limiter.Admit(asClient("second"), "GetAggregatedCategoryScoresOverTime", 100)
+		if err == nil {
+			release()
+		}
+		queued <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	release()
+	assert.Nil(t, <-queued)
+}
+
+func TestGrpcRateLimitReturnsRetryInfo(t *testing.T) {
+	db, err := sql.Open("sqlite", testDatabase)
+	assert.Nil(t, err)
+	defer db.Close()
+	limiter := ratelimit.NewLimiter(ratelimit.Options{CostPerSecond: 1, Burst: 7})
+	scoreService := ratelimit.NewScoreService(limiter, service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db)))
+	client, closer := grpcServerWithService(scoreService, util.DefaultRangeLimits)
+	defer closer()
+	from := time.Date(2019, 7, 17, 0, 0, 0, 0, time.UTC)
+	request := &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(from.AddDate(0, 0, 7))}
+
+	_, err = client.GetOverAllQualityScore(context.Background(), request)
+	assert.Nil(t, err)
+
+	var header metadata.MD
+	_, err = client.GetOverAllQualityScore(context.Background(), request, grpc.Header(&header))
+	st := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Len(t, st.Details(), 1)
+	retryInfo, ok := st.Details()[0].(*errdetails.RetryInfo)
+	assert.True(t, ok)
+	assert.InDelta(t, 7*time.Second, retryInfo.GetRetryDelay().AsDuration(), float64(100*time.Millisecond))
+	assert.Equal(t, []string{"7"}, header.Get(apperror.RetryAfterHeader))
+
+	stream, err := client.GetScoreByTicket(context.Background(), request)
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	streamHeader, err := stream.Header()
+	assert.Nil(t, err)
+	assert.NotEmpty(t, streamHeader.Get(apperror.RetryAfterHeader))
+}
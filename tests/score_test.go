@@ -5,16 +5,19 @@ import (
 	"database/sql"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/fernandoalava/softwareengineer-test-task/repository"
 	"github.com/fernandoalava/softwareengineer-test-task/service"
 	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	_ "modernc.org/sqlite"
 )
 
 func getScoreService() (*service.ScoreService, func()) {
-	db, err := sql.Open("sqlite", "../database.db")
+	db, err := sql.Open("sqlite", testDatabase)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -37,7 +40,7 @@ func TestGetScoreByTicket(t *testing.T) {
 	from, _ := util.StringToTime("2019-07-05T00:00:00")
 	to, _ := util.StringToTime("2019-07-06T23:59:00")
 
-	results, err := scoreService.GetScoreByTicket(context.TODO(), from, to)
+	results, err := scoreService.GetScoreByTicket(defaultWorkspace(context.TODO()), from, to)
 	assert.Nil(t, err)
 	assert.NotEmpty(t, results)
 }
@@ -49,9 +52,9 @@ func TestGetOverAllQualityScore(t *testing.T) {
 	from, _ := util.StringToTime("2019-07-17T00:00:00")
 	to, _ := util.StringToTime("2019-07-17T23:59:00")
 
-	results, err := scoreService.GetOverAllQualityScore(context.TODO(), from, to)
+	results, err := scoreService.GetOverAllQualityScore(defaultWorkspace(context.TODO()), from, to)
 	assert.Nil(t, err)
-	assert.Equal(t, float64(49.37), results)
+	assert.Equal(t, lo.ToPtr(49.37), results)
 }
 
 func TestGetAggregatedCategoryScoresOverTime(t *testing.T) {
@@ -61,7 +64,7 @@ func TestGetAggregatedCategoryScoresOverTime(t *testing.T) {
 	from, _ := util.StringToTime("2019-03-01T00:00:00")
 	to, _ := util.StringToTime("2019-04-30T00:00:00")
 
-	results, err := scoreService.GetAggregatedCategoryScoresOverTime(context.TODO(), from, to)
+	results, err := scoreService.GetAggregatedCategoryScoresOverTime(defaultWorkspace(context.TODO()), from, to)
 	assert.Nil(t, err)
 	assert.NotEmpty(t, results)
 }
@@ -73,7 +76,92 @@ func TestGetPeriodOverPeriodScoreChange(t *testing.T) {
 	from, _ := util.StringToTime("2019-07-17T00:00:00")
 	to, _ := util.StringToTime("2019-07-17T23:59:00")
 
-	results, err := scoreService.GetPeriodOverPeriodScoreChange(context.TODO(), from, to)
+	results, err := scoreService.GetPeriodOverPeriodScoreChange(defaultWorkspace(context.TODO()), from, to)
 	assert.Nil(t, err)
-	assert.Equal(t, 0.04, results.ScoreDifference)
+	assert.Equal(t, lo.ToPtr(0.04), results.ScoreDifference)
+}
+
+// sparseScoreService serves a genuine 0% rating on the first day, nothing on the second, two
+// 100% ratings on the third and a rating of a category weighing 0 on the first.
+func sparseScoreService(t *testing.T) *service.ScoreService {
+	db := seededDatabase(t, "sparse.db", `
+		INSERT INTO rating_categories (id, name, weight) VALUES (1, 'Tone', 1), (2, 'Random', 0);
+		INSERT INTO tickets (id, subject, created_at) VALUES (1, 'first', '2024-03-04T09:00:00');
+		INSERT INTO ratings (rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at) VALUES
+			(0, 1, 1, 1, 2, '2024-03-04T10:00:00'),
+			(5, 1, 1, 1, 2, '2024-03-06T10:00:00'),
+			(5, 1, 1, 1, 2, '2024-03-06T11:00:00'),
+			(3, 1, 2, 1, 2, '2024-03-04T10:00:00');
+	`)
+	return service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db))
+}
+
+func TestAggregatesDistinguishMissingScoresFromZero(t *testing.T) {
+	scoreService := sparseScoreService(t)
+	ctx := workspace.WithID(context.Background(), workspace.DefaultID)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	results, err := scoreService.GetAggregatedCategoryScoresOverTime(ctx, from, from.AddDate(0, 0, 3))
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+
+	tone := results[0]
+	assert.Equal(t, "Tone", tone.CategoryName)
+	assert.Equal(t, []*float64{lo.ToPtr(0.0), nil, lo.ToPtr(100.0)}, lo.Map(tone.PeriodScoresWithRatings, func(period service.PeriodScoreWithRatings, _ int) *float64 {
+		return period.Score
+	}))
+	// The total weighs the three ratings alike, the mean the two days with a score alike; the
+	// empty second day is left out of both instead of counting as 0%.
+	assert.Equal(t, lo.ToPtr(66.67), tone.TotalScore)
+	assert.Equal(t, lo.ToPtr(50.0), tone.MeanPeriodScore)
+	assert.Equal(t, uint32(3), tone.TotalRating)
+
+	random := results[1]
+	assert.Equal(t, "Random", random.CategoryName)
+	assert.Equal(t, uint32(1), random.PeriodScoresWithRatings[0].Ratings)
+	assert.Nil(t, random.PeriodScoresWithRatings[0].Score)
+	assert.Nil(t, random.TotalScore)
+	assert.Nil(t, random.MeanPeriodScore)
+	assert.Equal(t, uint32(1), random.TotalRating)
+
+	overall, err := scoreService.GetOverAllQualityScore(ctx, from, from.AddDate(0, 0, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, lo.ToPtr(0.0), overall)
+	overall, err = scoreService.GetOverAllQualityScore(ctx, from.AddDate(0, 0, 1), from.AddDate(0, 0, 2))
+	assert.Nil(t, err)
+	assert.Nil(t, overall)
+}
+
+func TestAggregatesKeepCategoriesSharingANameApart(t *testing.T) {
+	db := seededDatabase(t, "categories.db", `
+		INSERT INTO rating_categories (id, name, weight) VALUES (3, 'Tone', 4), (1, 'Tone', 3), (2, 'Empathy', 2);
+		INSERT INTO tickets (id, subject, created_at) VALUES (1, 'first', '2024-03-04T09:00:00');
+		INSERT INTO ratings (rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at) VALUES
+			(5, 1, 1, 1, 2, '2024-03-04T10:00:00'),
+			(0, 1, 3, 1, 2, '2024-03-04T10:00:00'),
+			(0, 1, 3, 1, 2, '2024-03-04T11:00:00');
+	`)
+	scoreService := service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db))
+	ctx := workspace.WithID(context.Background(), workspace.DefaultID)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	categoryIDs := func(results []service.CategoryScoreOverTime) []uint64 {
+		return lo.Map(results, func(category service.CategoryScoreOverTime, _ int) uint64 { return category.CategoryID })
+	}
+
+	results, err := scoreService.GetAggregatedCategoryScoresOverTime(ctx, from, from.AddDate(0, 0, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{1, 2, 3}, categoryIDs(results))
+	assert.Equal(t, float32(3), results[0].CategoryWeight)
+	assert.Equal(t, uint32(1), results[0].TotalRating)
+	assert.Equal(t, lo.ToPtr(100.0), results[0].TotalScore)
+	assert.Equal(t, uint32(2), results[2].TotalRating)
+	assert.Equal(t, lo.ToPtr(0.0), results[2].TotalScore)
+
+	results, err = scoreService.WithCategoryOrder(service.CategoryOrderName).GetAggregatedCategoryScoresOverTime(ctx, from, from.AddDate(0, 0, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{2, 1, 3}, categoryIDs(results))
+
+	results, err = scoreService.WithCategoryOrder(service.CategoryOrderWeight).GetAggregatedCategoryScoresOverTime(ctx, from, from.AddDate(0, 0, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{3, 1, 2}, categoryIDs(results))
 }
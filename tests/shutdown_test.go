@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/fernandoalava/softwareengineer-test-task/server"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	_ "modernc.org/sqlite"
+)
+
+func TestGracefulStopCompletesInFlightStream(t *testing.T) {
+	db, err := sql.Open("sqlite", testDatabase)
+	assert.Nil(t, err)
+	defer db.Close()
+
+	// Hold the stream handler until the shutdown has started so the RPC is really in flight.
+	started := make(chan struct{})
+	release := make(chan struct{})
+	holdStream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		close(started)
+		<-release
+		return handler(srv, ss)
+	}
+
+	lis := bufconn.Listen(1024 * 1024)
+	baseServer := grpc.NewServer(grpc.ChainStreamInterceptor(holdStream, workspace.StreamServerInterceptor(workspace.DefaultID)))
+	scoreService := service.NewScoreService(repository.NewRatingCategoryRepository(db), repository.NewScoreRepository(db))
+	pb.RegisterScoresServer(baseServer, server.NewScoreServer(scoreService, util.DefaultRangeLimits))
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(baseServer, healthServer)
+	go func() {
+		if err := baseServer.Serve(lis); err != nil {
+			log.Printf("error serving server: %v", err)
+		}
+	}()
+
+	conn, err := grpc.NewClient("passthrough://bufnet", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Nil(t, err)
+	defer conn.Close()
+	client := pb.NewScoresClient(conn)
+
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-17T23:59:00")
+	stream, err := client.GetScoreByTicket(context.TODO(), &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)})
+	assert.Nil(t, err)
+	<-started
+
+	drained := make(chan bool)
+	go func() {
+		drained <- server.GracefulStop(baseServer, healthServer, 5*time.Second)
+	}()
+
+	assert.Eventually(t, func() bool {
+		response, err := healthServer.Check(context.TODO(), &healthpb.HealthCheckRequest{})
+		return err == nil && response.GetStatus() == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 10*time.Millisecond)
+	select {
+	case <-drained:
+		t.Fatal("server stopped before the in-flight stream completed")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(release)
+	var outs []*pb.ScoreByTicket
+	for {
+		o, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if !assert.Nil(t, err) {
+			break
+		}
+		outs = append(outs, o)
+	}
+
+	assert.NotEmpty(t, outs)
+	assert.True(t, <-drained)
+}
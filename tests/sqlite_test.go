@@ -0,0 +1,83 @@
+package tests
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/config"
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLiteDSN(t *testing.T) {
+	dsn := repository.SQLiteDSN("data/scores?.db", repository.SQLiteOptions{
+		ReadOnly:     true,
+		Immutable:    true,
+		BusyTimeout:  5 * time.Second,
+		CacheSizeKiB: 16384,
+		MmapSize:     1 << 28,
+	})
+
+	assert.Equal(t, "file:data/scores%3f.db?_pragma=busy_timeout%285000%29&_pragma=cache_size%28-16384%29&_pragma=mmap_size%28268435456%29&immutable=1&mode=ro", dsn)
+}
+
+func TestSQLiteDSNWritable(t *testing.T) {
+	dsn := repository.SQLiteDSN("scores.db", repository.SQLiteOptions{JournalMode: "wal"})
+
+	assert.Equal(t, "file:scores.db?_pragma=journal_mode%28wal%29&mode=rw", dsn)
+}
+
+func TestOpenSQLiteReadOnly(t *testing.T) {
+	db, err := repository.OpenSQLite(context.Background(), testDatabase, repository.SQLiteOptions{ReadOnly: true, BusyTimeout: time.Second})
+	assert.Nil(t, err)
+	defer db.Close()
+
+	var count int
+	assert.Nil(t, db.QueryRow("SELECT count(*) FROM rating_categories").Scan(&count))
+	assert.Greater(t, count, 0)
+
+	_, err = db.Exec("DELETE FROM rating_categories")
+	assert.ErrorContains(t, err, "readonly")
+}
+
+func TestOpenSQLiteMissingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.db")
+
+	for _, readOnly := range []bool{true, false} {
+		_, err := repository.OpenSQLite(context.Background(), path, repository.SQLiteOptions{ReadOnly: readOnly})
+		assert.ErrorIs(t, err, os.ErrNotExist)
+		_, statErr := os.Stat(path)
+		assert.ErrorIs(t, statErr, os.ErrNotExist)
+	}
+}
+
+func TestOpenSQLiteWAL(t *testing.T) {
+	source, err := os.ReadFile(testDatabase)
+	assert.Nil(t, err)
+	path := filepath.Join(t.TempDir(), "scores.db")
+	assert.Nil(t, os.WriteFile(path, source, 0o600))
+
+	db, err := repository.OpenSQLite(context.Background(), path, repository.SQLiteOptions{JournalMode: "wal"})
+	assert.Nil(t, err)
+	defer db.Close()
+
+	var mode string
+	assert.Nil(t, db.QueryRow("PRAGMA journal_mode").Scan(&mode))
+	assert.Equal(t, "wal", mode)
+}
+
+func TestConfigDatabaseValidation(t *testing.T) {
+	_, err := config.Load("test", []string{"-db-dsn", "database.db", "-db-journal-mode", "wal"}, envLookup(nil))
+	assert.ErrorContains(t, err, "database.journal_mode can only be changed when database.read_only is false")
+
+	_, err = config.Load("test", []string{"-db-dsn", "database.db", "-db-read-only=false", "-db-immutable=true"}, envLookup(nil))
+	assert.ErrorContains(t, err, "database.immutable requires database.read_only")
+
+	cfg, err := config.Load("test", []string{"-db-dsn", "database.db", "-db-read-only=false", "-db-journal-mode", "wal"}, envLookup(nil))
+	assert.Nil(t, err)
+	assert.False(t, cfg.Database.ReadOnly)
+	assert.Equal(t, "wal", cfg.Database.JournalMode)
+}
@@ -0,0 +1,88 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTimeRangeRejectsEmptyRange(t *testing.T) {
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+
+	assert.NotNil(t, util.ValidateTimeRange(from, from))
+	assert.NotNil(t, util.ValidateTimeRange(from, from.Add(-time.Second)))
+	assert.Nil(t, util.ValidateTimeRange(from, from.Add(time.Nanosecond)))
+}
+
+func TestCalculatePreviousPeriodIsAdjacent(t *testing.T) {
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-18T00:00:00")
+
+	previousFrom, previousTo := util.CalculatePreviousPeriod(from, to)
+
+	assert.Equal(t, from, previousTo)
+	assert.Equal(t, to.Sub(from), previousTo.Sub(previousFrom))
+}
+
+func TestTimeToPreciseStringKeepsSubSecondPrecision(t *testing.T) {
+	value, _ := util.StringToTime("2019-07-17T23:59:59")
+
+	assert.Equal(t, "2019-07-17T23:59:59", util.TimeToPreciseString(value))
+	assert.Equal(t, "2019-07-17T23:59:59.5", util.TimeToPreciseString(value.Add(500*time.Millisecond)))
+	assert.Less(t, "2019-07-17T23:59:59", util.TimeToPreciseString(value.Add(500*time.Millisecond)))
+}
+
+func TestGenerateDateRangesAreHalfOpen(t *testing.T) {
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-19T00:00:00")
+
+	ranges := util.GenerateDateRanges(from, to)
+
+	assert.Len(t, ranges, 2)
+	assert.Equal(t, from, ranges[0].From)
+	assert.Equal(t, ranges[0].To, ranges[1].From)
+	assert.Equal(t, to, ranges[1].To)
+}
+
+func TestGenerateWeeklyDateRangesMatchParsedPeriods(t *testing.T) {
+	from, _ := util.StringToTime("2019-03-01T00:00:00")
+	to, _ := util.StringToTime("2019-04-30T00:00:00")
+
+	ranges := util.GenerateDateRanges(from, to)
+	period, err := util.ParsePeriodFromString("2019-02-25/2019-03-04", "/")
+
+	assert.Nil(t, err)
+	assert.Equal(t, *period, ranges[0])
+	assert.Equal(t, time.Monday, ranges[len(ranges)-1].From.Weekday())
+	assert.False(t, ranges[len(ranges)-1].To.Before(to))
+}
+
+func TestParseDateRange(t *testing.T) {
+	// A Wednesday.
+	now := time.Date(2019, 7, 17, 15, 4, 5, 0, time.UTC)
+	day := func(month time.Month, day int) time.Time { return time.Date(2019, month, day, 0, 0, 0, 0, time.UTC) }
+
+	for value, expected := range map[string]util.DateRange{
+		"today":                  {From: day(7, 17), To: day(7, 18)},
+		"yesterday":              {From: day(7, 16), To: day(7, 17)},
+		"this-week":              {From: day(7, 15), To: day(7, 22)},
+		"last-week":              {From: day(7, 8), To: day(7, 15)},
+		"this-month":             {From: day(7, 1), To: day(8, 1)},
+		"last-month":             {From: day(6, 1), To: day(7, 1)},
+		"2019-02":                {From: day(2, 1), To: day(3, 1)},
+		"2019-07-04":             {From: day(7, 4), To: day(7, 5)},
+		"2019-07-01..2019-07-31": {From: day(7, 1), To: day(8, 1)},
+		"2019-07-01..2019-07-01": {From: day(7, 1), To: day(7, 2)},
+	} {
+		dateRange, err := util.ParseDateRange(value, now)
+		assert.Nil(t, err, value)
+		assert.Equal(t, expected, dateRange, value)
+	}
+
+	for _, value := range []string{"", "last-year", "2019-13", "2019-07-31..2019-07-01", "2019-07-01..", "2019-07-01T00:00:00Z"} {
+		_, err := util.ParseDateRange(value, now)
+		assert.NotNil(t, err, value)
+	}
+}
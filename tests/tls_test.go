@@ -0,0 +1,201 @@
+package tests
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/server"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+}
+
+// issueCertificate creates a certificate for name signed by parent, or a self-signed CA when
+// parent is nil.
+func issueCertificate(t *testing.T, name string, serial int64, parent *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{name},
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature
+	} else {
+		signer, signerKey = parent.certificate, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	assert.Nil(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	assert.Nil(t, err)
+	return &testCertificate{certificate: certificate, key: key}
+}
+
+func (c *testCertificate) certPEM() []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.certificate.Raw})
+}
+
+func (c *testCertificate) keyPEM(t *testing.T) []byte {
+	der, err := x509.MarshalECPrivateKey(c.key)
+	assert.Nil(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func (c *testCertificate) tlsCertificate(t *testing.T) tls.Certificate {
+	certificate, err := tls.X509KeyPair(c.certPEM(), c.keyPEM(t))
+	assert.Nil(t, err)
+	return certificate
+}
+
+// writeServerFiles writes the certificate and key of c, moving their modification time forward
+// so a reload is detected even within the file system's timestamp resolution.
+func writeServerFiles(t *testing.T, files server.TLSFiles, c *testCertificate, modTime time.Time) {
+	assert.Nil(t, os.WriteFile(files.CertFile, c.certPEM(), 0o600))
+	assert.Nil(t, os.WriteFile(files.KeyFile, c.keyPEM(t), 0o600))
+	assert.Nil(t, os.Chtimes(files.CertFile, modTime, modTime))
+	assert.Nil(t, os.Chtimes(files.KeyFile, modTime, modTime))
+}
+
+func tlsServer(t *testing.T, files server.TLSFiles) *bufconn.Listener {
+	tlsConfig, err := server.NewTLSConfig(files)
+	assert.Nil(t, err)
+
+	lis := bufconn.Listen(1024 * 1024)
+	baseServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	healthpb.RegisterHealthServer(baseServer, grpchealth.NewServer())
+	go func() {
+		_ = baseServer.Serve(lis)
+	}()
+	t.Cleanup(baseServer.Stop)
+	return lis
+}
+
+// tlsCheck runs a health check over a new connection and returns the certificate the server
+// presented.
+func tlsCheck(lis *bufconn.Listener, clientConfig *tls.Config) (*x509.Certificate, error) {
+	conn, err := grpc.NewClient("passthrough://bufnet", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}), grpc.WithTransportCredentials(credentials.NewTLS(clientConfig)))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	var p peer.Peer
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}, grpc.Peer(&p))
+	if err != nil {
+		return nil, err
+	}
+	return p.AuthInfo.(credentials.TLSInfo).State.PeerCertificates[0], nil
+}
+
+func tlsFiles(t *testing.T) server.TLSFiles {
+	dir := t.TempDir()
+	return server.TLSFiles{CertFile: filepath.Join(dir, "server.crt"), KeyFile: filepath.Join(dir, "server.key")}
+}
+
+func TestTLS(t *testing.T) {
+	ca := issueCertificate(t, "test CA", 1, nil)
+	files := tlsFiles(t)
+	writeServerFiles(t, files, issueCertificate(t, "bufnet", 2, ca), time.Now())
+	lis := tlsServer(t, files)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.certificate)
+	certificate, err := tlsCheck(lis, &tls.Config{RootCAs: roots, ServerName: "bufnet"})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), certificate.SerialNumber.Int64())
+}
+
+func TestTLSReloadsChangedCertificate(t *testing.T) {
+	ca := issueCertificate(t, "test CA", 1, nil)
+	files := tlsFiles(t)
+	files.RetryInterval = 500 * time.Millisecond
+	writeServerFiles(t, files, issueCertificate(t, "bufnet", 2, ca), time.Now())
+	lis := tlsServer(t, files)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.certificate)
+	clientConfig := &tls.Config{RootCAs: roots, ServerName: "bufnet"}
+
+	writeServerFiles(t, files, issueCertificate(t, "bufnet", 3, ca), time.Now().Add(time.Minute))
+	certificate, err := tlsCheck(lis, clientConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), certificate.SerialNumber.Int64())
+
+	// A broken rotation keeps the last good certificate in use.
+	fixed := issueCertificate(t, "bufnet", 4, ca)
+	assert.Nil(t, os.WriteFile(files.KeyFile, []byte("not a key"), 0o600))
+	later := time.Now().Add(2 * time.Minute)
+	assert.Nil(t, os.Chtimes(files.KeyFile, later, later))
+	certificate, err = tlsCheck(lis, clientConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), certificate.SerialNumber.Int64())
+
+	// Once fixed, the files are only loaded again after the retry interval.
+	writeServerFiles(t, files, fixed, time.Now().Add(3*time.Minute))
+	certificate, err = tlsCheck(lis, clientConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), certificate.SerialNumber.Int64())
+	time.Sleep(files.RetryInterval)
+	certificate, err = tlsCheck(lis, clientConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), certificate.SerialNumber.Int64())
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := issueCertificate(t, "test CA", 1, nil)
+	files := tlsFiles(t)
+	files.ClientCAFile = filepath.Join(filepath.Dir(files.CertFile), "clients.crt")
+	writeServerFiles(t, files, issueCertificate(t, "bufnet", 2, ca), time.Now())
+	assert.Nil(t, os.WriteFile(files.ClientCAFile, ca.certPEM(), 0o600))
+	lis := tlsServer(t, files)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.certificate)
+
+	_, err := tlsCheck(lis, &tls.Config{RootCAs: roots, ServerName: "bufnet"})
+	assert.NotNil(t, err)
+
+	otherCA := issueCertificate(t, "other CA", 10, nil)
+	_, err = tlsCheck(lis, &tls.Config{RootCAs: roots, ServerName: "bufnet", Certificates: []tls.Certificate{issueCertificate(t, "agent", 11, otherCA).tlsCertificate(t)}})
+	assert.NotNil(t, err)
+
+	_, err = tlsCheck(lis, &tls.Config{RootCAs: roots, ServerName: "bufnet", Certificates: []tls.Certificate{issueCertificate(t, "agent", 3, ca).tlsCertificate(t)}})
+	assert.Nil(t, err)
+}
+
+func TestNewTLSConfigFailsOnMissingFiles(t *testing.T) {
+	_, err := server.NewTLSConfig(tlsFiles(t))
+
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"connectrpc.com/connect"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1/scoresv1connect"
+	"github.com/fernandoalava/softwareengineer-test-task/tracing"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTracingContinuesIncomingTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	client, closer := grpcServer()
+	defer closer()
+
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-17T23:59:00")
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	ctx := metadata.AppendToOutgoingContext(context.TODO(), "traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+
+	_, err := client.GetOverAllQualityScore(ctx, &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)})
+	assert.Nil(t, err)
+
+	spans := lo.KeyBy(recorder.Ended(), func(span sdktrace.ReadOnlySpan) string { return span.Name() })
+	serverSpan, ok := spans["scores.v1.Scores/GetOverAllQualityScore"]
+	assert.True(t, ok)
+	assert.Equal(t, traceID, serverSpan.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", serverSpan.Parent().SpanID().String())
+
+	serviceSpan, ok := spans["ScoreService.GetOverAllQualityScore"]
+	assert.True(t, ok)
+	assert.Equal(t, serverSpan.SpanContext().SpanID(), serviceSpan.Parent().SpanID())
+
+	querySpan, ok := spans["ScoreRepository.FetchOverallQuality"]
+	assert.True(t, ok)
+	assert.Equal(t, serviceSpan.SpanContext().SpanID(), querySpan.Parent().SpanID())
+	attributes := lo.SliceToMap(querySpan.Attributes(), func(kv attribute.KeyValue) (string, string) { return string(kv.Key), kv.Value.Emit() })
+	assert.Equal(t, "2019-07-17T00:00:00Z", attributes["scores.range.from"])
+	assert.Equal(t, "1", attributes["db.response.returned_rows"])
+}
+
+func TestGatewayForwardsTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	httpServer := gatewayServer(t, nil, grpc.ChainUnaryInterceptor(tracing.UnaryServerInterceptor()))
+	traceID := "4bf92f3577b34da6a3ce929d0e0e4736"
+	traceparent := "00-" + traceID + "-00f067aa0ba902b7-01"
+
+	response, _ := getJSON(t, httpServer.URL+"/v1/scores/overall?"+gatewayRange, http.Header{"Traceparent": {traceparent}})
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+
+	from, _ := util.StringToTime("2019-07-17T00:00:00")
+	to, _ := util.StringToTime("2019-07-17T23:59:00")
+	request := connect.NewRequest(&pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(to)})
+	request.Header().Set("Traceparent", traceparent)
+	_, err := scoresv1connect.NewScoresClient(http.DefaultClient, httpServer.URL).GetOverAllQualityScore(context.TODO(), request)
+	assert.Nil(t, err)
+
+	serverSpans := lo.Filter(recorder.Ended(), func(span sdktrace.ReadOnlySpan, _ int) bool {
+		return span.Name() == "scores.v1.Scores/GetOverAllQualityScore"
+	})
+	assert.Len(t, serverSpans, 2)
+	for _, span := range serverSpans {
+		assert.Equal(t, traceID, span.SpanContext().TraceID().String())
+		assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	}
+}
@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"connectrpc.com/connect"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/gateway"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	pbv2 "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v2"
+	"github.com/fernandoalava/softwareengineer-test-task/server"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBothVersionsAreServedSideBySide(t *testing.T) {
+	scoreService := sparseScoreService(t)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(workspace.UnaryServerInterceptor(workspace.DefaultID)),
+		grpc.ChainStreamInterceptor(workspace.StreamServerInterceptor(workspace.DefaultID)),
+	)
+	pb.RegisterScoresServer(grpcServer, server.NewScoreServer(scoreService, util.DefaultRangeLimits))
+	pbv2.RegisterScoresServer(grpcServer, server.NewScoreServerV2(scoreService, util.DefaultRangeLimits))
+	conn, stop, err := gateway.DialInProcess(grpcServer)
+	assert.Nil(t, err)
+	t.Cleanup(stop)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	v1Stream, err := pb.NewScoresClient(conn).GetAggregatedCategoryScoresOverTime(ctx, &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(from.AddDate(0, 0, 3))})
+	assert.Nil(t, err)
+	v1Tone, err := v1Stream.Recv()
+	assert.Nil(t, err)
+	v2Stream, err := pbv2.NewScoresClient(conn).GetAggregatedCategoryScoresOverTime(ctx, &pbv2.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(from.AddDate(0, 0, 3))})
+	assert.Nil(t, err)
+	v2Tone, err := v2Stream.Recv()
+	assert.Nil(t, err)
+
+	assert.Equal(t, "Tone", v2Tone.GetCategoryName())
+	// 66.67 is what the service computes; a float only holds 66.66999816894531.
+	assert.Equal(t, 66.67, v2Tone.GetTotalScore())
+	assert.NotEqual(t, 66.67, float64(v1Tone.GetTotalScore()))
+	assert.Equal(t, float32(66.67), v1Tone.GetTotalScore())
+	assert.Equal(t, 50.0, v2Tone.GetMeanPeriodScore())
+	assert.Nil(t, v2Tone.GetPeriodScoresWithRatings()[1].Score)
+	assert.Equal(t, v1Tone.GetTotalRating(), v2Tone.GetTotalRating())
+
+	overall, err := pbv2.NewScoresClient(conn).GetOverAllQualityScore(ctx, &pbv2.DateRangeRequest{From: timestamppb.New(from.AddDate(0, 0, 1)), To: timestamppb.New(from.AddDate(0, 0, 2))})
+	assert.Nil(t, err)
+	assert.Nil(t, overall.OverallScore)
+}
+
+func TestGatewayServesVersion2(t *testing.T) {
+	httpServer := gatewayServer(t, nil)
+
+	response, body := getJSON(t, httpServer.URL+"/v2/scores/period-over-period?"+gatewayRange, nil)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, body, "scoreDifference")
+
+	response, err := http.Get(httpServer.URL + gateway.OpenAPIV2Path)
+	assert.Nil(t, err)
+	defer response.Body.Close()
+	document, err := io.ReadAll(response.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Contains(t, string(document), "/v2/scores/overall")
+}
+
+func TestLegacyPackageIsStillServed(t *testing.T) {
+	scoreService := sparseScoreService(t)
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(workspace.UnaryServerInterceptor(workspace.DefaultID)),
+		grpc.ChainStreamInterceptor(workspace.StreamServerInterceptor(workspace.DefaultID)),
+	)
+	scoreServer := server.NewScoreServer(scoreService, util.DefaultRangeLimits)
+	pb.RegisterScoresServer(grpcServer, scoreServer)
+	server.RegisterLegacyScoresServer(grpcServer, scoreServer)
+	conn, stop, err := gateway.DialInProcess(grpcServer)
+	assert.Nil(t, err)
+	t.Cleanup(stop)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	request := &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(from.AddDate(0, 0, 3))}
+
+	current, err := pb.NewScoresClient(conn).GetOverAllQualityScore(context.Background(), request)
+	assert.Nil(t, err)
+	legacy := &pb.OverAllQualityScoreResponse{}
+	assert.Nil(t, conn.Invoke(context.Background(), "/grpc.Scores/GetOverAllQualityScore", request, legacy))
+	assert.Equal(t, current.GetOverAllScore(), legacy.GetOverAllScore())
+
+	httpServer := gatewayServer(t, scoreService)
+	client := connect.NewClient[pb.DateRangeRequest, pb.OverAllQualityScoreResponse](http.DefaultClient, httpServer.URL+"/grpc.Scores/GetOverAllQualityScore")
+	response, err := client.CallUnary(context.Background(), connect.NewRequest(request))
+	assert.Nil(t, err)
+	assert.Equal(t, current.GetOverAllScore(), response.Msg.GetOverAllScore())
+}
@@ -0,0 +1,178 @@
+package tests
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	pb "github.com/fernandoalava/softwareengineer-test-task/proto/scores/v1"
+	"github.com/fernandoalava/softwareengineer-test-task/repository"
+	"github.com/fernandoalava/softwareengineer-test-task/service"
+	"github.com/fernandoalava/softwareengineer-test-task/util"
+	"github.com/fernandoalava/softwareengineer-test-task/workspace"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const legacySchema = `
+CREATE TABLE users (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, email TEXT NOT NULL);
+CREATE TABLE tickets (id INTEGER PRIMARY KEY AUTOINCREMENT, subject TEXT NOT NULL, created_at DATETIME NOT NULL);
+CREATE TABLE rating_categories (id INTEGER PRIMARY KEY AUTOINCREMENT, name TEXT NOT NULL, weight REAL NOT NULL);
+CREATE TABLE ratings (id INTEGER PRIMARY KEY AUTOINCREMENT, rating INTEGER NOT NULL, ticket_id INTEGER NOT NULL, rating_category_id INTEGER NOT NULL, reviewer_id INTEGER NOT NULL, reviewee_id INTEGER NOT NULL, created_at DATETIME NOT NULL);
+`
+
+// seededDatabase creates the database name with legacySchema, migrates it and runs inserts.
+func seededDatabase(t *testing.T, name string, inserts string) *sql.DB {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), name))
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = db.Exec(legacySchema)
+	assert.Nil(t, err)
+	assert.Nil(t, repository.Migrate(context.Background(), db))
+	_, err = db.Exec(inserts)
+	assert.Nil(t, err)
+	return db
+}
+
+// tenantDatabase creates a database with two workspaces. Workspace 1 rates its ticket 5 in its
+// own "Tone" category. Workspace 2 rates its ticket 1 in "Spelling" and also has a rating that
+// points at workspace 1's category and ticket, which must never be joined.
+func tenantDatabase(t *testing.T) *sql.DB {
+	return seededDatabase(t, "tenants.db", `
+		INSERT INTO rating_categories (id, name, weight, workspace_id) VALUES (1, 'Tone', 1, 1), (2, 'Spelling', 2, 2);
+		INSERT INTO tickets (id, subject, created_at, workspace_id) VALUES (1, 'first', '2024-03-04T09:00:00', 1), (2, 'second', '2024-03-04T09:00:00', 2);
+		INSERT INTO ratings (rating, ticket_id, rating_category_id, reviewer_id, reviewee_id, created_at, workspace_id) VALUES
+			(5, 1, 1, 1, 2, '2024-03-04T10:00:00', 1),
+			(1, 2, 2, 3, 4, '2024-03-04T10:00:00', 2),
+			(1, 1, 1, 3, 4, '2024-03-04T11:00:00', 2);
+	`)
+}
+
+func TestMigrateAssignsExistingRowsToDefaultWorkspace(t *testing.T) {
+	db, err := sql.Open("sqlite", filepath.Join(t.TempDir(), "legacy.db"))
+	assert.Nil(t, err)
+	defer db.Close()
+	_, err = db.Exec(legacySchema + `INSERT INTO rating_categories (name, weight) VALUES ('Tone', 1);`)
+	assert.Nil(t, err)
+	assert.NotNil(t, repository.VerifySchema(context.Background(), db))
+
+	assert.Nil(t, repository.Migrate(context.Background(), db))
+	assert.Nil(t, repository.Migrate(context.Background(), db))
+
+	assert.Nil(t, repository.VerifySchema(context.Background(), db))
+	var version int
+	assert.Nil(t, db.QueryRow("PRAGMA user_version").Scan(&version))
+	assert.Equal(t, 1, version)
+	var workspaceID int64
+	assert.Nil(t, db.QueryRow("SELECT workspace_id FROM rating_categories").Scan(&workspaceID))
+	assert.Equal(t, workspace.DefaultID, workspaceID)
+}
+
+func TestRepositoriesRequireWorkspace(t *testing.T) {
+	db := tenantDatabase(t)
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	_, err := repository.NewRatingCategoryRepository(db).FetchAll(context.Background())
+	assert.ErrorIs(t, err, workspace.ErrMissing)
+	_, err = repository.NewScoreRepository(db).FetchScoreByTicketBetween(context.Background(), from, to)
+	assert.ErrorIs(t, err, workspace.ErrMissing)
+	_, err = repository.NewScoreRepository(db).FetchAggregateScoreOverPeriod(context.Background(), from, to)
+	assert.ErrorIs(t, err, workspace.ErrMissing)
+	_, err = repository.NewScoreRepository(db).FetchOverallQuality(context.Background(), from, to)
+	assert.ErrorIs(t, err, workspace.ErrMissing)
+}
+
+func TestCachedRatingCategoriesAreCopies(t *testing.T) {
+	categories := repository.NewCachedRatingCategoryRepository(repository.NewRatingCategoryRepository(tenantDatabase(t)), time.Minute)
+	ctx := workspace.WithID(context.Background(), 1)
+
+	first, err := categories.FetchAll(ctx)
+	assert.Nil(t, err)
+	first[0].Name = "changed"
+	second, err := categories.FetchAll(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, "Tone", second[0].Name)
+}
+
+func TestWorkspacesAreIsolated(t *testing.T) {
+	db := tenantDatabase(t)
+	categories := repository.NewCachedRatingCategoryRepository(repository.NewRatingCategoryRepository(db), time.Minute)
+	scoreService := service.NewScoreService(categories, repository.NewScoreRepository(db))
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 1)
+
+	first := workspace.WithID(context.Background(), 1)
+	second := workspace.WithID(context.Background(), 2)
+
+	overall, err := scoreService.GetOverAllQualityScore(first, from, to)
+	assert.Nil(t, err)
+	assert.Equal(t, lo.ToPtr(100.0), overall)
+	overall, err = scoreService.GetOverAllQualityScore(second, from, to)
+	assert.Nil(t, err)
+	assert.Equal(t, lo.ToPtr(20.0), overall)
+
+	tickets, err := scoreService.GetScoreByTicket(second, from, to)
+	assert.Nil(t, err)
+	assert.Len(t, tickets, 1)
+	assert.Equal(t, uint64(2), tickets[0].TicketID)
+	assert.Equal(t, "Spelling", tickets[0].RatingCategoryScores[0].RatingCategoryName)
+
+	overTime, err := scoreService.GetAggregatedCategoryScoresOverTime(first, from, to)
+	assert.Nil(t, err)
+	assert.Len(t, overTime, 1)
+	assert.Equal(t, "Tone", overTime[0].CategoryName)
+	assert.Equal(t, uint32(1), overTime[0].TotalRating)
+	overTime, err = scoreService.GetAggregatedCategoryScoresOverTime(second, from, to)
+	assert.Nil(t, err)
+	assert.Len(t, overTime, 1)
+	assert.Equal(t, "Spelling", overTime[0].CategoryName)
+
+	empty, err := scoreService.GetScoreByTicket(workspace.WithID(context.Background(), 3), from, to)
+	assert.Nil(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestAuthenticatedCallersOnlySeeTheirWorkspace(t *testing.T) {
+	db := tenantDatabase(t)
+	authenticator, err := auth.NewAuthenticator(auth.Options{
+		APIKeysFile: writeFile(t, "api_keys.yaml", `api_keys:
+  - subject: first
+    workspace_id: 1
+    sha256: `+apiKeyHash("key-1")+`
+  - subject: second
+    workspace_id: 2
+    sha256: `+apiKeyHash("key-2")+`
+`),
+	})
+	assert.Nil(t, err)
+
+	// The default workspace interceptor of the helper must not override the authenticated one.
+	client, closer := grpcServerWithDatabase(db, util.DefaultRangeLimits,
+		grpc.ChainUnaryInterceptor(auth.UnaryServerInterceptor(authenticator)),
+		grpc.ChainStreamInterceptor(auth.StreamServerInterceptor(authenticator)),
+	)
+	defer closer()
+	from := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	request := &pb.DateRangeRequest{From: timestamppb.New(from), To: timestamppb.New(from.AddDate(0, 0, 1))}
+
+	response, err := client.GetOverAllQualityScore(metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "key-2"), request)
+	assert.Nil(t, err)
+	assert.InDelta(t, 20.0, response.GetOverAllScore(), 0.001)
+
+	response, err = client.GetOverAllQualityScore(metadata.AppendToOutgoingContext(context.Background(), "x-api-key", "key-1"), request)
+	assert.Nil(t, err)
+	assert.InDelta(t, 100.0, response.GetOverAllScore(), 0.001)
+
+	_, err = client.GetOverAllQualityScore(context.Background(), request)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,92 @@
+package tracing
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadataCarrier adapts incoming gRPC metadata to a propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (carrier metadataCarrier) Get(key string) string {
+	values := metadata.MD(carrier).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (carrier metadataCarrier) Set(key string, value string) {
+	metadata.MD(carrier).Set(key, value)
+}
+
+func (carrier metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier))
+	for key := range carrier {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func startServerSpan(ctx context.Context, fullMethod string) (context.Context, trace.Span) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	return Tracer().Start(ctx, strings.TrimPrefix(fullMethod, "/"),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("rpc.service", service),
+			attribute.String("rpc.method", method),
+		),
+	)
+}
+
+func endServerSpan(span trace.Span, err error) {
+	st := status.Convert(err)
+	span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(st.Code())))
+	if err != nil {
+		span.SetStatus(codes.Error, st.Message())
+	}
+	span.End()
+}
+
+// UnaryServerInterceptor starts a server span for every unary RPC, continuing the trace
+// propagated in the W3C trace-context headers of the request metadata.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		endServerSpan(span, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor starts a server span for every streaming RPC, continuing the trace
+// propagated in the W3C trace-context headers of the request metadata.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		err := handler(srv, &tracedServerStream{ServerStream: ss, ctx: ctx})
+		endServerSpan(span, err)
+		return err
+	}
+}
+
+type tracedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *tracedServerStream) Context() context.Context {
+	return stream.ctx
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/fernandoalava/softwareengineer-test-task"
+	defaultServiceName  = "score-service"
+)
+
+func init() {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Tracer returns the tracer of the service, backed by the global tracer provider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+func exporterConfigured() bool {
+	if os.Getenv("OTEL_TRACES_EXPORTER") == "none" {
+		return false
+	}
+	_, endpoint := os.LookupEnv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	_, tracesEndpoint := os.LookupEnv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT")
+	return endpoint || tracesEndpoint
+}
+
+// Setup installs an OTLP/gRPC exporting tracer provider when OTEL_EXPORTER_OTLP_ENDPOINT or
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT is set, the exporter itself is configured with the standard
+// OTEL_EXPORTER_OTLP_* variables. Otherwise the global no-op provider is kept. The returned
+// function flushes and stops the provider.
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	if !exporterConfigured() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceName(defaultServiceName)),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter), sdktrace.WithResource(res))
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// RangeAttributes describes the requested date range on a span.
+func RangeAttributes(from, to time.Time) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("scores.range.from", from.UTC().Format(time.RFC3339Nano)),
+		attribute.String("scores.range.to", to.UTC().Format(time.RFC3339Nano)),
+	}
+}
+
+// End records err on span, if any, and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
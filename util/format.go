@@ -7,10 +7,18 @@ import (
 
 const DateTimeDefaultStringFormat = "2006-01-02T15:04:05"
 
+// DateTimePreciseStringFormat keeps fractional seconds (trailing zeros trimmed) so that
+// formatted values still compare correctly against the second precision values stored in the DB.
+const DateTimePreciseStringFormat = "2006-01-02T15:04:05.999999999"
+
 func TimeToString(value time.Time) string {
 	return value.Format(DateTimeDefaultStringFormat)
 }
 
+func TimeToPreciseString(value time.Time) string {
+	return value.UTC().Format(DateTimePreciseStringFormat)
+}
+
 func StringToTime(value string) (t time.Time, err error) {
 	t, err = time.Parse(DateTimeDefaultStringFormat, value)
 	return
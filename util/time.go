@@ -2,77 +2,130 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"time"
+
+	"github.com/fernandoalava/softwareengineer-test-task/apperror"
 )
 
+// DateRange is a half-open interval [From, To).
 type DateRange struct {
 	From time.Time
 	To   time.Time
 }
 
+// WeeklyAggregationThresholdDays is the range length in days above which aggregates are
+// computed per week instead of per day.
+const WeeklyAggregationThresholdDays = 31
+
 func isValidTime(time time.Time) bool {
 	return !time.IsZero()
 }
 
 func ValidateTimeRange(from, to time.Time) error {
 	if !isValidTime(from) {
-		return errors.New("invalid [From]")
+		return apperror.NewValidationError("from", "must be set")
 	}
 	if !isValidTime(to) {
-		return errors.New("invalid [To]")
+		return apperror.NewValidationError("to", "must be set")
 	}
-	if from.After(to) {
-		return errors.New("invalid range [From] is after [To]")
+	if !from.Before(to) {
+		return apperror.NewValidationError("from", "must be before [To]")
 	}
 	return nil
 }
 
+// RangeLimits controls how a partially specified range is completed and how long it may be.
+type RangeLimits struct {
+	// DefaultRange is the length used when [From] is omitted.
+	DefaultRange time.Duration
+	// MaxRange is the longest accepted range, zero disables the check.
+	MaxRange time.Duration
+}
+
+var DefaultRangeLimits = RangeLimits{DefaultRange: 7 * 24 * time.Hour}
+
+// ResolveTimeRange fills in omitted bounds and validates the result: a missing [To] defaults to
+// now and a missing [From] to [To] minus the default range, so an empty request covers the last
+// DefaultRange. Only nil bounds are omitted, a zero one is rejected.
+func ResolveTimeRange(from, to *time.Time, now time.Time, limits RangeLimits) (time.Time, time.Time, error) {
+	if from != nil && from.IsZero() {
+		return time.Time{}, time.Time{}, apperror.NewValidationError("from", "must not be the zero time")
+	}
+	if to != nil && to.IsZero() {
+		return time.Time{}, time.Time{}, apperror.NewValidationError("to", "must not be the zero time")
+	}
+	var resolvedFrom, resolvedTo time.Time
+	if to != nil {
+		resolvedTo = *to
+	} else {
+		resolvedTo = now
+	}
+	if from != nil {
+		resolvedFrom = *from
+	} else {
+		resolvedFrom = resolvedTo.Add(-limits.DefaultRange)
+	}
+	err := ValidateTimeRange(resolvedFrom, resolvedTo)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if limits.MaxRange > 0 && resolvedTo.Sub(resolvedFrom) > limits.MaxRange {
+		return time.Time{}, time.Time{}, apperror.NewValidationError("to", fmt.Sprintf("range must not be longer than %s", limits.MaxRange))
+	}
+	return resolvedFrom, resolvedTo, nil
+}
+
+func startOfDay(value time.Time) time.Time {
+	year, month, day := value.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func startOfWeek(value time.Time) time.Time {
+	day := startOfDay(value)
+	weekDay := int(day.Weekday())
+	if weekDay == 0 {
+		weekDay = 7
+	}
+	return day.AddDate(0, 0, -(weekDay - 1))
+}
+
 func generateDailyRanges(from, to time.Time) []DateRange {
 	var ranges []DateRange
-	currentDate := from
-	for !currentDate.After(to) {
-		ranges = append(ranges, DateRange{From: currentDate, To: currentDate})
-		currentDate = currentDate.AddDate(0, 0, 1)
+	for currentDate := startOfDay(from); currentDate.Before(to); currentDate = currentDate.AddDate(0, 0, 1) {
+		ranges = append(ranges, DateRange{From: currentDate, To: currentDate.AddDate(0, 0, 1)})
 	}
 	return ranges
 }
 
 func generateWeeklyRanges(from, to time.Time) []DateRange {
 	var ranges []DateRange
-	fromWeekDay := int(from.Weekday())
-	if fromWeekDay == 0 {
-		fromWeekDay = 7
-	}
-	currentStartOfWeek := from.AddDate(0, 0, -(fromWeekDay - 1))
-	for !currentStartOfWeek.After(to) {
-		currentEndOfWeek := currentStartOfWeek.AddDate(0, 0, 6)
-		if currentEndOfWeek.After(to) {
-			currentEndOfWeek = to
-		}
-		ranges = append(ranges, DateRange{From: currentStartOfWeek, To: currentEndOfWeek})
-		currentStartOfWeek = currentEndOfWeek.AddDate(0, 0, 1)
+	for currentStartOfWeek := startOfWeek(from); currentStartOfWeek.Before(to); currentStartOfWeek = currentStartOfWeek.AddDate(0, 0, 7) {
+		ranges = append(ranges, DateRange{From: currentStartOfWeek, To: currentStartOfWeek.AddDate(0, 0, 7)})
 	}
 	return ranges
 }
 
+// GenerateDateRanges splits [from, to) into the half-open daily or weekly buckets used by
+// the aggregated scores; buckets are aligned to UTC days and ISO weeks.
 func GenerateDateRanges(from, to time.Time) []DateRange {
 	diff := to.Sub(from).Hours() / 24
-	if diff > 30 {
+	if diff > WeeklyAggregationThresholdDays {
 		return generateWeeklyRanges(from, to)
 	}
 	return generateDailyRanges(from, to)
 
 }
 
+// CalculatePreviousPeriod returns the range of the same length ending where [from, to) starts.
 func CalculatePreviousPeriod(from, to time.Time) (time.Time, time.Time) {
 	duration := to.Sub(from)
-	previousEndDate := from.Add(-time.Nanosecond)
-	previousStartDate := previousEndDate.Add(-duration)
-
-	return previousStartDate, previousEndDate
+	return from.Add(-duration), from
 }
 
+// ParsePeriodFromString parses either a single day ("2006-01-02"), read as the range covering
+// that whole day, or an explicit "from<separator>to" pair whose end is exclusive.
 func ParsePeriodFromString(periodStr string, separator string) (*DateRange, error) {
 	period := strings.Split(periodStr, separator)
 	var fromStr string
@@ -82,7 +135,6 @@ func ParsePeriodFromString(periodStr string, separator string) (*DateRange, erro
 		toStr = period[1]
 	} else if len(period) == 1 {
 		fromStr = period[0]
-		toStr = period[0]
 	} else {
 		return nil, errors.New("invalid period string")
 	}
@@ -90,6 +142,9 @@ func ParsePeriodFromString(periodStr string, separator string) (*DateRange, erro
 	if err != nil {
 		return nil, err
 	}
+	if len(toStr) == 0 {
+		return &DateRange{From: from, To: from.AddDate(0, 0, 1)}, nil
+	}
 	to, err := StringToTimeWithFormat(toStr, "2006-01-02")
 	if err != nil {
 		return nil, err
@@ -97,3 +152,46 @@ func ParsePeriodFromString(periodStr string, separator string) (*DateRange, erro
 	return &DateRange{From: from, To: to}, nil
 
 }
+
+// DateRangeSeparator separates the first and last day of a range given to ParseDateRange.
+const DateRangeSeparator = ".."
+
+// ParseDateRange parses a human-friendly range relative to now: "today", "yesterday",
+// "this-week", "last-week", "this-month", "last-month", a month ("2019-07"), a day
+// ("2019-07-17") or the first and last day of the range ("2019-07-01..2019-07-31"), both
+// included. Days are UTC and weeks start on Monday, like the aggregation buckets.
+func ParseDateRange(value string, now time.Time) (DateRange, error) {
+	today := startOfDay(now)
+	thisWeek := startOfWeek(now)
+	thisMonth := today.AddDate(0, 0, 1-today.Day())
+	switch value {
+	case "today":
+		return DateRange{From: today, To: today.AddDate(0, 0, 1)}, nil
+	case "yesterday":
+		return DateRange{From: today.AddDate(0, 0, -1), To: today}, nil
+	case "this-week":
+		return DateRange{From: thisWeek, To: thisWeek.AddDate(0, 0, 7)}, nil
+	case "last-week":
+		return DateRange{From: thisWeek.AddDate(0, 0, -7), To: thisWeek}, nil
+	case "this-month":
+		return DateRange{From: thisMonth, To: thisMonth.AddDate(0, 1, 0)}, nil
+	case "last-month":
+		return DateRange{From: thisMonth.AddDate(0, -1, 0), To: thisMonth}, nil
+	}
+	if month, err := StringToTimeWithFormat(value, "2006-01"); err == nil {
+		return DateRange{From: month, To: month.AddDate(0, 1, 0)}, nil
+	}
+	first, last, isPair := strings.Cut(value, DateRangeSeparator)
+	period, err := ParsePeriodFromString(value, DateRangeSeparator)
+	if err != nil || (isPair && (first == "" || last == "")) {
+		return DateRange{}, fmt.Errorf("invalid range %q: expected a day, a month, first..last day or one of today, yesterday, this-week, last-week, this-month, last-month", value)
+	}
+	if isPair {
+		// The last day is included, unlike the exclusive end ParsePeriodFromString returns.
+		period.To = period.To.AddDate(0, 0, 1)
+	}
+	if err := ValidateTimeRange(period.From, period.To); err != nil {
+		return DateRange{}, fmt.Errorf("invalid range %q: the last day must not be before the first", value)
+	}
+	return *period, nil
+}
@@ -0,0 +1,58 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fernandoalava/softwareengineer-test-task/auth"
+	"google.golang.org/grpc"
+)
+
+// DefaultID is the workspace that rows existing before multi-tenancy are migrated into, and the
+// one served when authentication is disabled.
+const DefaultID int64 = 1
+
+// ErrMissing is returned by repositories called without a workspace, which is a wiring bug: the
+// interceptors assign one to every RPC.
+var ErrMissing = errors.New("no workspace in context")
+
+type idKey struct{}
+
+// WithID returns a copy of ctx scoped to workspace id.
+func WithID(ctx context.Context, id int64) context.Context {
+	return context.WithValue(ctx, idKey{}, id)
+}
+
+// FromContext returns the workspace of the authenticated caller, or the one set by WithID when
+// the request is not authenticated.
+func FromContext(ctx context.Context) (int64, bool) {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.WorkspaceID, identity.WorkspaceID > 0
+	}
+	id, ok := ctx.Value(idKey{}).(int64)
+	return id, ok && id > 0
+}
+
+// UnaryServerInterceptor scopes every call to workspace id. It is only installed when
+// authentication is disabled; authenticated callers always use their own workspace.
+func UnaryServerInterceptor(id int64) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(WithID(ctx, id), req)
+	}
+}
+
+// StreamServerInterceptor scopes every stream to workspace id, see UnaryServerInterceptor.
+func StreamServerInterceptor(id int64) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &workspaceServerStream{ServerStream: ss, ctx: WithID(ss.Context(), id)})
+	}
+}
+
+type workspaceServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *workspaceServerStream) Context() context.Context {
+	return stream.ctx
+}